@@ -0,0 +1,469 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const analyticsNamespace = "wc-analytics"
+
+// Analytics service, for the WooCommerce Analytics (wc-analytics) reports
+type AnalyticsService service
+
+// AnalyticsParams are the query parameters of the analytics reports. Setters
+// can be chained to build a query.
+type AnalyticsParams struct {
+	Page        int       `url:"page,omitempty"`
+	PerPage     int       `url:"per_page,omitempty"`
+	After       string   `url:"after,omitempty"`
+	Before      string   `url:"before,omitempty"`
+	Interval    string    `url:"interval,omitempty"`
+	Order       string    `url:"order,omitempty"`
+	OrderBy     string    `url:"orderby,omitempty"`
+	SegmentBy   string    `url:"segmentby,omitempty"`
+	Fields      []string  `url:"fields,omitempty,comma"`
+	Match       string    `url:"match,omitempty"`
+	StatusIs    []string  `url:"status_is,omitempty,comma"`
+	StatusIsNot []string  `url:"status_is_not,omitempty,comma"`
+	Products    []int     `url:"products,omitempty,comma"`
+	Variations  []int     `url:"variations,omitempty,comma"`
+	Categories  []int     `url:"categories,omitempty,comma"`
+	Coupons     []int     `url:"coupons,omitempty,comma"`
+	Taxes       []int     `url:"taxes,omitempty,comma"`
+	Customers   []int     `url:"customers,omitempty,comma"`
+	Search      string    `url:"search,omitempty"`
+	Type        string    `url:"type,omitempty"`
+	ForceCache  bool      `url:"force_cache_refresh,omitempty"`
+
+	ExtendedInfo bool `url:"extended_info,omitempty"`
+}
+
+const (
+	AnalyticsIntervalHour    = "hour"
+	AnalyticsIntervalDay     = "day"
+	AnalyticsIntervalWeek    = "week"
+	AnalyticsIntervalMonth   = "month"
+	AnalyticsIntervalQuarter = "quarter"
+	AnalyticsIntervalYear    = "year"
+)
+
+const (
+	AnalyticsSegmentByProduct      = "product"
+	AnalyticsSegmentByCategory     = "category"
+	AnalyticsSegmentByVariation    = "variation"
+	AnalyticsSegmentByCoupon       = "coupon"
+	AnalyticsSegmentByCustomerType = "customer_type"
+)
+
+// AnalyticsStats is the response of the analytics stats reports
+type AnalyticsStats[T any] struct {
+	Totals    AnalyticsTotals[T]     `json:"totals"`
+	Intervals []AnalyticsInterval[T] `json:"intervals,omitempty"`
+}
+
+// AnalyticsTotals holds report totals and, when segmented, the totals of each segment
+type AnalyticsTotals[T any] struct {
+	Totals   T
+	Segments []AnalyticsSegment[T]
+}
+
+// AnalyticsInterval holds the totals of a single report interval
+type AnalyticsInterval[T any] struct {
+	Interval     string             `json:"interval,omitempty"`
+	DateStart    *WCTime            `json:"date_start,omitempty"`
+	DateStartGmt *WCTime            `json:"date_start_gmt,omitempty"`
+	DateEnd      *WCTime            `json:"date_end,omitempty"`
+	DateEndGmt   *WCTime            `json:"date_end_gmt,omitempty"`
+	Subtotals    AnalyticsTotals[T] `json:"subtotals"`
+}
+
+// AnalyticsSegment holds the totals of a single report segment
+type AnalyticsSegment[T any] struct {
+	SegmentId    interface{} `json:"segment_id,omitempty"`
+	SegmentLabel string      `json:"segment_label,omitempty"`
+	Subtotals    T           `json:"subtotals"`
+}
+
+// RevenueStatsTotals are the totals of the revenue stats report
+type RevenueStatsTotals struct {
+	OrdersCount  int   `json:"orders_count"`
+	NumItemsSold int   `json:"num_items_sold"`
+	GrossSales   Money `json:"gross_sales"`
+	TotalSales   Money `json:"total_sales"`
+	Coupons      Money `json:"coupons"`
+	CouponsCount int   `json:"coupons_count"`
+	Refunds      Money `json:"refunds"`
+	Taxes        Money `json:"taxes"`
+	Shipping     Money `json:"shipping"`
+	NetRevenue   Money `json:"net_revenue"`
+	Products     int   `json:"products,omitempty"`
+}
+
+// OrdersStatsTotals are the totals of the orders stats report
+type OrdersStatsTotals struct {
+	OrdersCount           int     `json:"orders_count"`
+	NumItemsSold          int     `json:"num_items_sold"`
+	AvgItemsPerOrder      float64 `json:"avg_items_per_order"`
+	AvgOrderValue         Money   `json:"avg_order_value"`
+	NetRevenue            Money   `json:"net_revenue"`
+	Coupons               Money   `json:"coupons"`
+	CouponsCount          int     `json:"coupons_count"`
+	NumReturningCustomers int     `json:"num_returning_customers"`
+	NumNewCustomers       int     `json:"num_new_customers"`
+	Products              int     `json:"products,omitempty"`
+}
+
+// AnalyticsProduct is an entry of the products report
+type AnalyticsProduct struct {
+	ProductId    int                   `json:"product_id,omitempty"`
+	ItemsSold    int                   `json:"items_sold"`
+	NetRevenue   Money                 `json:"net_revenue"`
+	OrdersCount  int                   `json:"orders_count"`
+	ExtendedInfo *AnalyticsProductInfo `json:"extended_info,omitempty"`
+}
+
+// AnalyticsVariation is an entry of the variations report
+type AnalyticsVariation struct {
+	ProductId    int                   `json:"product_id,omitempty"`
+	VariationId  int                   `json:"variation_id,omitempty"`
+	ItemsSold    int                   `json:"items_sold"`
+	NetRevenue   Money                 `json:"net_revenue"`
+	OrdersCount  int                   `json:"orders_count"`
+	ExtendedInfo *AnalyticsProductInfo `json:"extended_info,omitempty"`
+}
+
+// AnalyticsProductInfo is the extended product information of the products and variations reports
+type AnalyticsProductInfo struct {
+	Name           string      `json:"name,omitempty"`
+	Price          Money       `json:"price,omitempty"`
+	Image          string      `json:"image,omitempty"`
+	Permalink      string      `json:"permalink,omitempty"`
+	StockStatus    string      `json:"stock_status,omitempty"`
+	StockQuantity  interface{} `json:"stock_quantity,omitempty"`
+	ManageStock    bool        `json:"manage_stock,omitempty"`
+	LowStockAmount interface{} `json:"low_stock_amount,omitempty"`
+	Sku            string      `json:"sku,omitempty"`
+	CategoryIds    []int       `json:"category_ids,omitempty"`
+	Variations     []int       `json:"variations,omitempty"`
+	Attributes     interface{} `json:"attributes,omitempty"`
+}
+
+// AnalyticsCategory is an entry of the categories report
+type AnalyticsCategory struct {
+	CategoryId    int   `json:"category_id,omitempty"`
+	ItemsSold     int   `json:"items_sold"`
+	NetRevenue    Money `json:"net_revenue"`
+	OrdersCount   int   `json:"orders_count"`
+	ProductsCount int   `json:"products_count"`
+	ExtendedInfo  *struct {
+		Name string `json:"name,omitempty"`
+	} `json:"extended_info,omitempty"`
+}
+
+// AnalyticsCoupon is an entry of the coupons report
+type AnalyticsCoupon struct {
+	CouponId     int   `json:"coupon_id,omitempty"`
+	Amount       Money `json:"amount"`
+	OrdersCount  int   `json:"orders_count"`
+	ExtendedInfo *struct {
+		Code           string  `json:"code,omitempty"`
+		DateCreated    *WCTime `json:"date_created,omitempty"`
+		DateCreatedGmt *WCTime `json:"date_created_gmt,omitempty"`
+		DateExpires    *WCTime `json:"date_expires,omitempty"`
+		DateExpiresGmt *WCTime `json:"date_expires_gmt,omitempty"`
+		DiscountType   string  `json:"discount_type,omitempty"`
+	} `json:"extended_info,omitempty"`
+}
+
+// AnalyticsTax is an entry of the taxes report
+type AnalyticsTax struct {
+	TaxRateId   int     `json:"tax_rate_id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	TaxRate     float64 `json:"tax_rate"`
+	Country     string  `json:"country,omitempty"`
+	State       string  `json:"state,omitempty"`
+	Priority    int     `json:"priority"`
+	TotalTax    Money   `json:"total_tax"`
+	OrderTax    Money   `json:"order_tax"`
+	ShippingTax Money   `json:"shipping_tax"`
+	OrdersCount int     `json:"orders_count"`
+}
+
+// AnalyticsStock is an entry of the stock report
+type AnalyticsStock struct {
+	Id             int         `json:"id,omitempty"`
+	ParentId       int         `json:"parent_id,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Sku            string      `json:"sku,omitempty"`
+	StockStatus    string      `json:"stock_status,omitempty"`
+	StockQuantity  interface{} `json:"stock_quantity,omitempty"`
+	ManageStock    bool        `json:"manage_stock"`
+	LowStockAmount interface{} `json:"low_stock_amount,omitempty"`
+	Links          *Links      `json:"_links,omitempty"`
+}
+
+// AnalyticsCustomer is an entry of the customers report
+type AnalyticsCustomer struct {
+	Id                int     `json:"id,omitempty"`
+	UserId            int     `json:"user_id,omitempty"`
+	Username          string  `json:"username,omitempty"`
+	Name              string  `json:"name,omitempty"`
+	Email             string  `json:"email,omitempty"`
+	Country           string  `json:"country,omitempty"`
+	City              string  `json:"city,omitempty"`
+	State             string  `json:"state,omitempty"`
+	Postcode          string  `json:"postcode,omitempty"`
+	DateRegistered    *WCTime `json:"date_registered,omitempty"`
+	DateRegisteredGmt *WCTime `json:"date_registered_gmt,omitempty"`
+	DateLastActive    *WCTime `json:"date_last_active,omitempty"`
+	DateLastActiveGmt *WCTime `json:"date_last_active_gmt,omitempty"`
+	DateLastOrder     *WCTime `json:"date_last_order,omitempty"`
+	OrdersCount       int     `json:"orders_count"`
+	TotalSpend        Money   `json:"total_spend"`
+	AvgOrderValue     Money   `json:"avg_order_value"`
+	Links             *Links  `json:"_links,omitempty"`
+}
+
+func (totals *AnalyticsTotals[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &totals.Totals); err != nil {
+		return err
+	}
+
+	var segmented struct {
+		Segments []AnalyticsSegment[T] `json:"segments"`
+	}
+
+	if err := json.Unmarshal(data, &segmented); err != nil {
+		return err
+	}
+
+	totals.Segments = segmented.Segments
+
+	return nil
+}
+
+// NewAnalyticsParams starts a new analytics query
+func NewAnalyticsParams() *AnalyticsParams {
+	return &AnalyticsParams{}
+}
+
+// Between limits the query to the given period, in store time
+func (params *AnalyticsParams) Between(after time.Time, before time.Time) *AnalyticsParams {
+	params.After = after.Format(WCTimeLayout)
+	params.Before = before.Format(WCTimeLayout)
+
+	return params
+}
+
+// WithInterval groups stats by the given interval (eg. AnalyticsIntervalDay)
+func (params *AnalyticsParams) WithInterval(interval string) *AnalyticsParams {
+	params.Interval = interval
+
+	return params
+}
+
+// WithSegmentBy segments stats by the given dimension (eg. AnalyticsSegmentByProduct)
+func (params *AnalyticsParams) WithSegmentBy(segmentBy string) *AnalyticsParams {
+	params.SegmentBy = segmentBy
+
+	return params
+}
+
+// WithPage sets the page and page size
+func (params *AnalyticsParams) WithPage(page int, perPage int) *AnalyticsParams {
+	params.Page = page
+	params.PerPage = perPage
+
+	return params
+}
+
+// WithOrder sorts results by the given field and direction ("asc" or "desc")
+func (params *AnalyticsParams) WithOrder(orderBy string, order string) *AnalyticsParams {
+	params.OrderBy = orderBy
+	params.Order = order
+
+	return params
+}
+
+// PreviousPeriod returns a copy of the query covering the period of the same
+// length right before it, to compare against
+func (params *AnalyticsParams) PreviousPeriod() (*AnalyticsParams, error) {
+	after, before, err := params.period()
+	if err != nil {
+		return nil, err
+	}
+
+	// Analytics periods are inclusive, end the previous one a second earlier
+	previousBefore := after.Add(-time.Second)
+
+	return params.shifted(previousBefore.Add(-before.Sub(after)), previousBefore), nil
+}
+
+// PreviousYear returns a copy of the query covering the same period one year before
+func (params *AnalyticsParams) PreviousYear() (*AnalyticsParams, error) {
+	after, before, err := params.period()
+	if err != nil {
+		return nil, err
+	}
+
+	return params.shifted(after.AddDate(-1, 0, 0), before.AddDate(-1, 0, 0)), nil
+}
+
+func (params *AnalyticsParams) period() (time.Time, time.Time, error) {
+	if params.After == "" || params.Before == "" {
+		return time.Time{}, time.Time{}, errors.New("analytics query has no period")
+	}
+
+	after, err := time.Parse(WCTimeLayout, params.After)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	before, err := time.Parse(WCTimeLayout, params.Before)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return after, before, nil
+}
+
+func (params *AnalyticsParams) shifted(after time.Time, before time.Time) *AnalyticsParams {
+	shifted := *params
+
+	return shifted.Between(after, before)
+}
+
+// Get the revenue stats report
+func (service *AnalyticsService) RevenueStats(opts *AnalyticsParams) (*AnalyticsStats[RevenueStatsTotals], *http.Response, error) {
+	stats := new(AnalyticsStats[RevenueStatsTotals])
+	response, err := service.get("/reports/revenue/stats", opts, stats)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return stats, response, nil
+}
+
+// Get the orders stats report
+func (service *AnalyticsService) OrdersStats(opts *AnalyticsParams) (*AnalyticsStats[OrdersStatsTotals], *http.Response, error) {
+	stats := new(AnalyticsStats[OrdersStatsTotals])
+	response, err := service.get("/reports/orders/stats", opts, stats)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return stats, response, nil
+}
+
+// CompareRevenueStats gets the revenue stats report for two periods, usually
+// built with PreviousPeriod or PreviousYear
+func (service *AnalyticsService) CompareRevenueStats(current *AnalyticsParams, previous *AnalyticsParams) (*AnalyticsStats[RevenueStatsTotals], *AnalyticsStats[RevenueStatsTotals], error) {
+	currentStats, _, err := service.RevenueStats(current)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previousStats, _, err := service.RevenueStats(previous)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return currentStats, previousStats, nil
+}
+
+// List the products report
+func (service *AnalyticsService) Products(opts *AnalyticsParams) ([]AnalyticsProduct, *http.Response, error) {
+	var products []AnalyticsProduct
+	response, err := service.get("/reports/products", opts, &products)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return products, response, nil
+}
+
+// List the variations report
+func (service *AnalyticsService) Variations(opts *AnalyticsParams) ([]AnalyticsVariation, *http.Response, error) {
+	var variations []AnalyticsVariation
+	response, err := service.get("/reports/variations", opts, &variations)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return variations, response, nil
+}
+
+// List the categories report
+func (service *AnalyticsService) Categories(opts *AnalyticsParams) ([]AnalyticsCategory, *http.Response, error) {
+	var categories []AnalyticsCategory
+	response, err := service.get("/reports/categories", opts, &categories)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return categories, response, nil
+}
+
+// List the coupons report
+func (service *AnalyticsService) Coupons(opts *AnalyticsParams) ([]AnalyticsCoupon, *http.Response, error) {
+	var coupons []AnalyticsCoupon
+	response, err := service.get("/reports/coupons", opts, &coupons)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return coupons, response, nil
+}
+
+// List the taxes report
+func (service *AnalyticsService) Taxes(opts *AnalyticsParams) ([]AnalyticsTax, *http.Response, error) {
+	var taxes []AnalyticsTax
+	response, err := service.get("/reports/taxes", opts, &taxes)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return taxes, response, nil
+}
+
+// List the stock report
+func (service *AnalyticsService) Stock(opts *AnalyticsParams) ([]AnalyticsStock, *http.Response, error) {
+	var stock []AnalyticsStock
+	response, err := service.get("/reports/stock", opts, &stock)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return stock, response, nil
+}
+
+// List the customers report
+func (service *AnalyticsService) Customers(opts *AnalyticsParams) ([]AnalyticsCustomer, *http.Response, error) {
+	var customers []AnalyticsCustomer
+	response, err := service.get("/reports/customers", opts, &customers)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return customers, response, nil
+}
+
+func (service *AnalyticsService) get(urlStr string, opts *AnalyticsParams, v interface{}) (*http.Response, error) {
+	req, err := service.client.NewNamespaceRequest("GET", analyticsNamespace, urlStr, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, v)
+}
@@ -0,0 +1,130 @@
+package woocommerce
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+const defaultCallPerPage = 100
+
+// Pagination holds the pagination headers of a list response
+type Pagination struct {
+	Total      int
+	TotalPages int
+}
+
+// Call sends a request to an endpoint of the default WooCommerce namespace
+// (eg. "/subscriptions/12") and decodes the response into a T. It is meant for
+// endpoints not modelled by this library, like the ones added by extensions.
+func Call[T any](ctx context.Context, client *Client, method, path string, query interface{}, body interface{}) (*T, *http.Response, error) {
+	return NamespaceCall[T](ctx, client, client.DefaultNamespace(), method, path, query, body)
+}
+
+// NamespaceCall is Call for an endpoint of another REST namespace (eg. "wc/v1")
+func NamespaceCall[T any](ctx context.Context, client *Client, namespace, method, path string, query interface{}, body interface{}) (*T, *http.Response, error) {
+	req, err := client.NewNamespaceRequest(method, namespace, path, query, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(T)
+	response, err := client.Do(req.WithContext(ctx), result)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// CallList lists a page of a collection endpoint of the given namespace
+func CallList[T any](ctx context.Context, client *Client, namespace, path string, query interface{}) ([]T, *Pagination, *http.Response, error) {
+	items, response, err := NamespaceCall[[]T](ctx, client, namespace, "GET", path, query, nil)
+	if err != nil {
+		return nil, nil, response, err
+	}
+
+	return *items, parsePagination(response), response, nil
+}
+
+// Paginate iterates over all items of a collection endpoint of the given
+// namespace, requesting one page at a time. The page and per_page parameters
+// of the query are overridden.
+func Paginate[T any](ctx context.Context, client *Client, namespace, path string, query interface{}) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 1; ; page++ {
+			req, err := client.NewNamespaceRequest("GET", namespace, path, query, nil)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			values := req.URL.Query()
+			values.Set("page", strconv.Itoa(page))
+			values.Set("per_page", strconv.Itoa(defaultCallPerPage))
+			req.URL.RawQuery = values.Encode()
+
+			var items []T
+			response, err := client.Do(req.WithContext(ctx), &items)
+
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// Last page? Trust the pagination headers when present: pages can be
+			// short when some items are filtered out (eg. by permissions)
+			if len(items) == 0 {
+				return
+			}
+
+			if response.Header.Get("X-Wp-Totalpages") != "" {
+				if page >= parsePagination(response).TotalPages {
+					return
+				}
+			} else if len(items) < defaultCallPerPage {
+				return
+			}
+		}
+	}
+}
+
+// ListAll collects all items of a collection endpoint of the given namespace
+func ListAll[T any](ctx context.Context, client *Client, namespace, path string, query interface{}) ([]T, error) {
+	var items []T
+
+	for item, err := range Paginate[T](ctx, client, namespace, path, query) {
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func parsePagination(response *http.Response) *Pagination {
+	pagination := &Pagination{}
+
+	if response == nil {
+		return pagination
+	}
+
+	pagination.Total, _ = strconv.Atoi(response.Header.Get("X-Wp-Total"))
+	pagination.TotalPages, _ = strconv.Atoi(response.Header.Get("X-Wp-Totalpages"))
+
+	return pagination
+}
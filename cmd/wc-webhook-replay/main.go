@@ -0,0 +1,143 @@
+// Command wc-webhook-replay captures WooCommerce webhook deliveries to a JSONL
+// file and replays them against a local handler.
+//
+// Capture deliveries (optionally forwarding them to a local handler):
+//
+//	wc-webhook-replay capture -listen :8080 -out deliveries.jsonl -forward http://localhost:3000/webhooks
+//
+// Replay captured deliveries, re-signed with a secret:
+//
+//	wc-webhook-replay replay -in deliveries.jsonl -url http://localhost:3000/webhooks -secret s3cret
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+	"github.com/sparklayer-io/go-woocommerce-api/webhook"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "capture":
+		err = capture(os.Args[2:])
+	case "replay":
+		err = replay(os.Args[2:])
+	default:
+		usage()
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: wc-webhook-replay capture|replay [flags]")
+	os.Exit(2)
+}
+
+func capture(args []string) error {
+	flags := flag.NewFlagSet("capture", flag.ExitOnError)
+	listen := flags.String("listen", ":8080", "address to listen on")
+	out := flags.String("out", "deliveries.jsonl", "capture file, appended to")
+	forward := flags.String("forward", "", "delivery URL to forward deliveries to (optional)")
+	secret := flags.String("secret", "", "secret to re-sign forwarded deliveries with (optional)")
+	flags.Parse(args)
+
+	file, err := os.OpenFile(*out, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var next http.Handler
+	if *forward != "" {
+		target := &woocommerce.Webhook{DeliveryUrl: *forward, Secret: *secret}
+		next = forwarder(target)
+	}
+
+	log.Printf("capturing deliveries on %s to %s", *listen, *out)
+
+	return http.ListenAndServe(*listen, webhook.Capture(file, next))
+}
+
+// forwarder relays captured deliveries to a local handler, answering with its status
+func forwarder(target *woocommerce.Webhook) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		delivery := &webhook.CapturedDelivery{Headers: r.Header, Body: string(body)}
+
+		response, err := webhook.Replay(r.Context(), nil, target, delivery)
+		if err != nil {
+			log.Printf("forward: %v", err)
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+
+		log.Printf("forwarded %s: %s", r.Header.Get(woocommerce.WebhookHeaderTopic), response.Status)
+		w.WriteHeader(response.StatusCode)
+	})
+}
+
+func replay(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	in := flags.String("in", "deliveries.jsonl", "capture file")
+	url := flags.String("url", "", "delivery URL to replay deliveries to")
+	secret := flags.String("secret", "", "secret to re-sign deliveries with (default: keep captured signatures)")
+	webhookId := flags.Int("webhook-id", 0, "webhook ID to set on deliveries (optional)")
+	delay := flags.Duration("delay", 0, "delay between deliveries")
+	flags.Parse(args)
+
+	if *url == "" {
+		return fmt.Errorf("replay: -url is required")
+	}
+
+	file, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	deliveries, err := webhook.ReadCaptured(file)
+	if err != nil {
+		return err
+	}
+
+	target := &woocommerce.Webhook{Id: *webhookId, DeliveryUrl: *url, Secret: *secret}
+
+	for index := range deliveries {
+		if index > 0 && *delay > 0 {
+			time.Sleep(*delay)
+		}
+
+		delivery := &deliveries[index]
+
+		response, err := webhook.Replay(context.Background(), nil, target, delivery)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("replayed %s (%s): %s", delivery.Headers.Get(woocommerce.WebhookHeaderTopic), delivery.Time.Format(time.RFC3339), response.Status)
+	}
+
+	return nil
+}
@@ -11,15 +11,15 @@ type CouponsService service
 type Coupon struct {
   Id                         int         `json:"id,omitempty"`
   Code                       string      `json:"code,omitempty"`
-  Amount                     string      `json:"amount,omitempty"`
-  DateCreated                string      `json:"date_created,omitempty"`
-  DateCreatedGmt             string      `json:"date_created_gmt,omitempty"`
-  DateModified               string      `json:"date_modified,omitempty"`
-  DateModifiedGmt            string      `json:"date_modified_gmt,omitempty"`
+  Amount                     Money       `json:"amount,omitempty"`
+  DateCreated                *WCTime     `json:"date_created,omitempty"`
+  DateCreatedGmt             *WCTime     `json:"date_created_gmt,omitempty"`
+  DateModified               *WCTime     `json:"date_modified,omitempty"`
+  DateModifiedGmt            *WCTime     `json:"date_modified_gmt,omitempty"`
   DiscountType               string      `json:"discount_type,omitempty"`
   Description                string      `json:"description,omitempty"`
-  DateExpires                string      `json:"date_expires,omitempty"`
-  DateExpiresGmt             string      `json:"date_expires_gmt,omitempty"`
+  DateExpires                *WCTime     `json:"date_expires,omitempty"`
+  DateExpiresGmt             *WCTime     `json:"date_expires_gmt,omitempty"`
   UsageCount                 int         `json:"usage_count,omitempty"`
   IndividualUse              bool        `json:"individual_use,omitempty"`
   UsageLimit                 int         `json:"usage_limit,omitempty"`
@@ -27,8 +27,8 @@ type Coupon struct {
   LimitUsageToXItems         int         `json:"limit_usage_to_x_items,omitempty"`
   FreeShipping               bool        `json:"free_shipping,omitempty"`
   ExcludeSaleItems           bool        `json:"exclude_sale_items,omitempty"`
-  MinimumAmount              string      `json:"minimum_amount,omitempty"`
-  MaximumAmount              string      `json:"maximum_amount,omitempty"`
+  MinimumAmount              Money       `json:"minimum_amount,omitempty"`
+  MaximumAmount              Money       `json:"maximum_amount,omitempty"`
   EmailRestrictions          interface{} `json:"email_restrictions,omitempty"`
   UsedBy                     interface{} `json:"used_by,omitempty"`
   ProductIds                 *[]int      `json:"product_ids,omitempty"`
@@ -53,6 +53,12 @@ type ListCouponParams struct {
   Before         string      `url:"before,omitempty"`
   ModifiedAfter  string      `url:"modified_after,omitempty"`
   ModifiedBefore string      `url:"modified_before,omitempty"`
+  // Dates as time values, sent in UTC: set DatesAreGmt with them. They take
+  // precedence over the string dates.
+  AfterGmt          *WCTime   `url:"after,omitempty"`
+  BeforeGmt         *WCTime   `url:"before,omitempty"`
+  ModifiedAfterGmt  *WCTime   `url:"modified_after,omitempty"`
+  ModifiedBeforeGmt *WCTime   `url:"modified_before,omitempty"`
   DatesAreGmt    bool        `url:"dates_are_gmt,omitempty"`
   Orderby        string      `url:"orderby,omitempty"`
   Code           string      `url:"code,omitempty"`
@@ -0,0 +1,204 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	downloadsRemainingUnlimited = "unlimited"
+	accessExpiresNever          = "never"
+)
+
+// DownloadsRemaining is the number of downloads left for a customer download,
+// encoded by the API as "unlimited" or a number
+type DownloadsRemaining struct {
+	Unlimited bool
+	Count     int
+}
+
+// AccessExpires is the expiry date of a customer download, encoded by the API
+// as "never" or a date without time zone. Local dates are parsed as UTC.
+type AccessExpires struct {
+	Never bool
+	Time  time.Time
+}
+
+// CustomerDownloadIssue is a customer download that can no longer be downloaded
+type CustomerDownloadIssue struct {
+	CustomerId int
+	Download   CustomerDownload
+	Expired    bool
+	Exhausted  bool
+}
+
+func (remaining DownloadsRemaining) MarshalJSON() ([]byte, error) {
+	if remaining.Unlimited {
+		return json.Marshal(downloadsRemainingUnlimited)
+	}
+
+	return json.Marshal(strconv.Itoa(remaining.Count))
+}
+
+func (remaining *DownloadsRemaining) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*remaining = DownloadsRemaining{}
+
+	switch v := value.(type) {
+	case float64:
+		remaining.Count = int(v)
+	case string:
+		if v == "" || strings.EqualFold(v, downloadsRemainingUnlimited) {
+			remaining.Unlimited = true
+
+			return nil
+		}
+
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+
+		remaining.Count = count
+	default:
+		remaining.Unlimited = true
+	}
+
+	return nil
+}
+
+func (expires AccessExpires) MarshalJSON() ([]byte, error) {
+	if expires.Never || expires.Time.IsZero() {
+		return json.Marshal(accessExpiresNever)
+	}
+
+	return WCTime{Time: expires.Time}.MarshalJSON()
+}
+
+func (expires *AccessExpires) UnmarshalJSON(data []byte) error {
+	var value string
+	if string(data) != "null" {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	}
+
+	*expires = AccessExpires{}
+
+	if value == "" || strings.EqualFold(value, accessExpiresNever) {
+		expires.Never = true
+
+		return nil
+	}
+
+	var parsed WCTime
+	if err := parsed.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	expires.Time = parsed.Time
+
+	return nil
+}
+
+// Expired tells whether the download access has expired at the given time
+func (download *CustomerDownload) Expired(now time.Time) bool {
+	return !download.AccessExpiresGmt.Never && !download.AccessExpiresGmt.Time.After(now)
+}
+
+// Exhausted tells whether the download has no downloads left
+func (download *CustomerDownload) Exhausted() bool {
+	return !download.DownloadsRemaining.Unlimited && download.DownloadsRemaining.Count <= 0
+}
+
+// FindUnavailableDownloads walks all customers matching the given parameters
+// and returns their downloads that are expired or exhausted at the given time
+func (service *CustomersService) FindUnavailableDownloads(opts *ListCustomerParams, now time.Time) ([]CustomerDownloadIssue, error) {
+	params := ListCustomerParams{}
+	if opts != nil {
+		params = *opts
+	}
+
+	if params.PerPage == 0 {
+		params.PerPage = 100
+	}
+
+	var issues []CustomerDownloadIssue
+
+	for page := max(params.Page, 1); ; page++ {
+		params.Page = page
+
+		customers, _, err := service.List(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, customer := range customers {
+			downloads, _, err := service.GetDownloads(customer.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, download := range *downloads {
+				expired, exhausted := download.Expired(now), download.Exhausted()
+
+				if expired || exhausted {
+					issues = append(issues, CustomerDownloadIssue{CustomerId: customer.Id, Download: download, Expired: expired, Exhausted: exhausted})
+				}
+			}
+		}
+
+		if len(customers) < params.PerPage {
+			return issues, nil
+		}
+	}
+}
+
+// RegrantDownloadPermissions grants the downloads of a processing or completed
+// order again, with the current download limit and expiry of the products
+// (counted from now), which extends expired or exhausted downloads. The
+// previous permissions are kept.
+//
+// The REST API cannot change permissions directly: the order is marked as not
+// granted and moved through the other paid status (processing or completed),
+// which makes WooCommerce grant them, then back to its status. Moving an order
+// to completed emails the customer, so this sends one "order completed" email.
+func (service *OrdersService) RegrantDownloadPermissions(orderId string) (*Order, error) {
+	order, _, err := service.Get(orderId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var via string
+
+	switch order.Status {
+	case "processing":
+		via = "completed"
+	case "completed":
+		via = "processing"
+	default:
+		return nil, fmt.Errorf("cannot grant download permissions of order %s with status %q", orderId, order.Status)
+	}
+
+	// WooCommerce maps this internal meta key to the order property setter,
+	// and grants the permissions on the status change saved with it
+	metaData := []MetaData{{Key: "_download_permissions_granted", Value: "no"}}
+
+	if _, _, err := service.Update(orderId, &Order{Status: via, MetaData: &metaData}); err != nil {
+		return nil, err
+	}
+
+	order, _, err = service.Update(orderId, &Order{Status: order.Status})
+	if err != nil {
+		return nil, fmt.Errorf("order %s left %s after granting download permissions: %w", orderId, via, err)
+	}
+
+	return order, nil
+}
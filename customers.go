@@ -11,10 +11,10 @@ type CustomersService service
 // Customer object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#customer-properties
 type Customer struct {
 	Id               int         `json:"id,omitempty"`
-	DateCreated      string      `json:"date_created,omitempty"`
-	DateCreatedGmt   string      `json:"date_created_gmt,omitempty"`
-	DateModified     string      `json:"date_modified,omitempty"`
-	DateModifiedGmt  string      `json:"date_modified_gmt,omitempty"`
+	DateCreated      *WCTime     `json:"date_created,omitempty"`
+	DateCreatedGmt   *WCTime     `json:"date_created_gmt,omitempty"`
+	DateModified     *WCTime     `json:"date_modified,omitempty"`
+	DateModifiedGmt  *WCTime     `json:"date_modified_gmt,omitempty"`
 	Email            string      `json:"email,omitempty"`
 	FirstName        string      `json:"first_name,omitempty"`
 	LastName         string      `json:"last_name,omitempty"`
@@ -61,18 +61,18 @@ type BatchCustomerUpdateResponse struct {
 }
 
 type CustomerDownload struct {
-	DownloadId         string `json:"download_id,omitempty"`
-	DownloadUrl        string `json:"download_url,omitempty"`
-	ProductId          int    `json:"product_id,omitempty"`
-	ProductName        string `json:"product_name,omitempty"`
-	DownloadName       string `json:"download_name,omitempty"`
-	OrderId            int    `json:"order_id,omitempty"`
-	OrderKey           string `json:"order_key,omitempty"`
-	DownloadsRemaining string `json:"downloads_remaining,omitempty"`
-	AccessExpires      string `json:"access_expires,omitempty"`
-	AccessExpiresGmt   string `json:"access_expires_gmt,omitempty"`
-	File               *File  `json:"file,omitempty"`
-	Links              *Links `json:"_links,omitempty"`
+	DownloadId         string             `json:"download_id,omitempty"`
+	DownloadUrl        string             `json:"download_url,omitempty"`
+	ProductId          int                `json:"product_id,omitempty"`
+	ProductName        string             `json:"product_name,omitempty"`
+	DownloadName       string             `json:"download_name,omitempty"`
+	OrderId            int                `json:"order_id,omitempty"`
+	OrderKey           string             `json:"order_key,omitempty"`
+	DownloadsRemaining DownloadsRemaining `json:"downloads_remaining"`
+	AccessExpires      AccessExpires      `json:"access_expires"`
+	AccessExpiresGmt   AccessExpires      `json:"access_expires_gmt"`
+	File               *File              `json:"file,omitempty"`
+	Links              *Links             `json:"_links,omitempty"`
 }
 
 type File struct {
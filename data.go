@@ -0,0 +1,195 @@
+package woocommerce
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Data service
+type DataService service
+
+// DataResource object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-data
+type DataResource struct {
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	Links       *Links `json:"_links,omitempty"`
+}
+
+// Continent object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#continent-properties
+type Continent struct {
+	Code      string             `json:"code,omitempty"`
+	Name      string             `json:"name,omitempty"`
+	Countries []ContinentCountry `json:"countries,omitempty"`
+	Links     *Links             `json:"_links,omitempty"`
+}
+
+// ContinentCountry holds a country of a continent with its locale information
+type ContinentCountry struct {
+	Code          string  `json:"code,omitempty"`
+	Name          string  `json:"name,omitempty"`
+	CurrencyCode  string  `json:"currency_code,omitempty"`
+	CurrencyPos   string  `json:"currency_pos,omitempty"`
+	DecimalSep    string  `json:"decimal_sep,omitempty"`
+	DimensionUnit string  `json:"dimension_unit,omitempty"`
+	NumDecimals   int     `json:"num_decimals,omitempty"`
+	ThousandSep   string  `json:"thousand_sep,omitempty"`
+	WeightUnit    string  `json:"weight_unit,omitempty"`
+	States        []State `json:"states,omitempty"`
+}
+
+// Country object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#country-properties
+type Country struct {
+	Code   string  `json:"code,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	States []State `json:"states,omitempty"`
+	Links  *Links  `json:"_links,omitempty"`
+}
+
+type State struct {
+	Code string `json:"code,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Currency object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#currency-properties
+type Currency struct {
+	Code   string `json:"code,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Symbol string `json:"symbol,omitempty"`
+	Links  *Links `json:"_links,omitempty"`
+}
+
+// List all data resources. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-data
+func (service *DataService) List() ([]DataResource, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resources []DataResource
+	response, err := service.client.Do(req, &resources)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return resources, response, nil
+}
+
+// List all continents. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-continents
+func (service *DataService) ListContinents() ([]Continent, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/continents", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var continents []Continent
+	response, err := service.client.Do(req, &continents)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return continents, response, nil
+}
+
+// Get a continent. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-continent-data
+func (service *DataService) GetContinent(code string) (*Continent, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/continents/"+strings.ToLower(code), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	continent := new(Continent)
+	response, err := service.client.Do(req, continent)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return continent, response, nil
+}
+
+// List all countries. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-countries
+func (service *DataService) ListCountries() ([]Country, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/countries", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var countries []Country
+	response, err := service.client.Do(req, &countries)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return countries, response, nil
+}
+
+// Get a country. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-country-data
+func (service *DataService) GetCountry(code string) (*Country, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/countries/"+strings.ToLower(code), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	country := new(Country)
+	response, err := service.client.Do(req, country)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return country, response, nil
+}
+
+// List all currencies. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-currencies
+func (service *DataService) ListCurrencies() ([]Currency, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/currencies", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var currencies []Currency
+	response, err := service.client.Do(req, &currencies)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return currencies, response, nil
+}
+
+// Get a currency. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-currency-data
+func (service *DataService) GetCurrency(code string) (*Currency, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/currencies/"+strings.ToLower(code), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currency := new(Currency)
+	response, err := service.client.Do(req, currency)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return currency, response, nil
+}
+
+// Get the current store currency. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-current-currency
+func (service *DataService) GetCurrentCurrency() (*Currency, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/data/currencies/current", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currency := new(Currency)
+	response, err := service.client.Do(req, currency)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return currency, response, nil
+}
@@ -0,0 +1,217 @@
+package woocommerce
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownCountry = errors.New("unknown country code")
+	ErrUnknownState   = errors.New("unknown state code")
+)
+
+const (
+	CurrencyPositionLeft       = "left"
+	CurrencyPositionRight      = "right"
+	CurrencyPositionLeftSpace  = "left_space"
+	CurrencyPositionRightSpace = "right_space"
+)
+
+// CurrencyFormat describes how the store formats prices
+type CurrencyFormat struct {
+	Currency          Currency
+	Position          string
+	ThousandSeparator string
+	DecimalSeparator  string
+	Decimals          int
+}
+
+// DataLookup caches the store countries and currency format to validate
+// addresses and format amounts without further requests
+type DataLookup struct {
+	service *DataService
+
+	mu        sync.RWMutex
+	countries map[string]Country
+	format    CurrencyFormat
+}
+
+// Lookup loads the store countries, current currency and general price
+// settings into a DataLookup
+func (service *DataService) Lookup() (*DataLookup, error) {
+	lookup := &DataLookup{service: service}
+
+	if err := lookup.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return lookup, nil
+}
+
+// Refresh reloads the cached countries and currency format
+func (lookup *DataLookup) Refresh() error {
+	countries, _, err := lookup.service.ListCountries()
+	if err != nil {
+		return err
+	}
+
+	currency, _, err := lookup.service.GetCurrentCurrency()
+	if err != nil {
+		return err
+	}
+
+	options, _, err := lookup.service.client.Settings.List("general")
+	if err != nil {
+		return err
+	}
+
+	format := CurrencyFormat{
+		Currency:          *currency,
+		Position:          CurrencyPositionLeft,
+		ThousandSeparator: ",",
+		DecimalSeparator:  ".",
+		Decimals:          2,
+	}
+	format.Currency.Symbol = html.UnescapeString(format.Currency.Symbol)
+
+	for _, option := range options {
+		switch option.Id {
+		case "woocommerce_currency_pos":
+			if value, ok := option.Value.(string); ok && value != "" {
+				format.Position = value
+			}
+		case "woocommerce_price_thousand_sep":
+			if value, ok := option.Value.(string); ok {
+				format.ThousandSeparator = value
+			}
+		case "woocommerce_price_decimal_sep":
+			if value, ok := option.Value.(string); ok {
+				format.DecimalSeparator = value
+			}
+		case "woocommerce_price_num_decimals":
+			if value, ok := option.Value.(float64); ok {
+				format.Decimals = int(value)
+			}
+		}
+	}
+
+	byCode := make(map[string]Country, len(countries))
+	for _, country := range countries {
+		byCode[strings.ToUpper(country.Code)] = country
+	}
+
+	lookup.mu.Lock()
+	defer lookup.mu.Unlock()
+
+	lookup.countries = byCode
+	lookup.format = format
+
+	return nil
+}
+
+// Country returns a country by its code
+func (lookup *DataLookup) Country(code string) (Country, bool) {
+	lookup.mu.RLock()
+	defer lookup.mu.RUnlock()
+
+	country, ok := lookup.countries[strings.ToUpper(code)]
+
+	return country, ok
+}
+
+// CurrencyFormat returns the store currency format
+func (lookup *DataLookup) CurrencyFormat() CurrencyFormat {
+	lookup.mu.RLock()
+	defer lookup.mu.RUnlock()
+
+	return lookup.format
+}
+
+// ValidateAddress checks that a country code exists and, when given, that the
+// state code belongs to that country
+func (lookup *DataLookup) ValidateAddress(countryCode string, stateCode string) error {
+	country, ok := lookup.Country(countryCode)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownCountry, countryCode)
+	}
+
+	// Countries without states accept any free form state
+	if stateCode == "" || len(country.States) == 0 {
+		return nil
+	}
+
+	for _, state := range country.States {
+		if strings.EqualFold(state.Code, stateCode) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q for country %q", ErrUnknownState, stateCode, country.Code)
+}
+
+// ValidateBilling checks the country and state codes of a billing address
+func (lookup *DataLookup) ValidateBilling(billing *Billing) error {
+	return lookup.ValidateAddress(billing.Country, billing.State)
+}
+
+// ValidateShipping checks the country and state codes of a shipping address
+func (lookup *DataLookup) ValidateShipping(shipping *Shipping) error {
+	return lookup.ValidateAddress(shipping.Country, shipping.State)
+}
+
+// FormatAmount formats an amount with the store currency symbol, position,
+// separators and number of decimals
+func (lookup *DataLookup) FormatAmount(amount Money) string {
+	return lookup.CurrencyFormat().Format(amount)
+}
+
+// Round rounds an amount to the currency number of decimals
+func (format CurrencyFormat) Round(amount Money) Money {
+	return amount.Round(format.Decimals)
+}
+
+// Format formats an amount according to the currency format
+func (format CurrencyFormat) Format(amount Money) string {
+	rounded := format.Round(amount)
+	negative := rounded.Sign() < 0
+	number := rounded.Abs().String()
+
+	integer, fraction, _ := strings.Cut(number, ".")
+
+	// Group integer digits by thousands
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteString(format.ThousandSeparator)
+		}
+
+		grouped.WriteRune(digit)
+	}
+
+	number = grouped.String()
+	if fraction != "" {
+		number += format.DecimalSeparator + fraction
+	}
+
+	symbol := format.Currency.Symbol
+
+	switch format.Position {
+	case CurrencyPositionRight:
+		number = number + symbol
+	case CurrencyPositionLeftSpace:
+		number = symbol + " " + number
+	case CurrencyPositionRightSpace:
+		number = number + " " + symbol
+	default:
+		number = symbol + number
+	}
+
+	if negative {
+		number = "-" + number
+	}
+
+	return number
+}
@@ -1,5 +1,9 @@
 package woocommerce
 
+import (
+  "encoding/json"
+)
+
 type MetaData struct {
   ID           int          `json:"id,omitempty"`
   Key          string       `json:"key,omitempty"`
@@ -15,9 +19,14 @@ type Collection struct {
   Href string `json:"href,omitempty"`
 }
 
+type Up struct {
+  Href string `json:"href,omitempty"`
+}
+
 type Links struct {
   Self       []Self       `json:"self,omitempty"`
   Collection []Collection `json:"collection,omitempty"`
+  Up         []Up         `json:"up,omitempty"`
 }
 
 type Billing struct {
@@ -45,4 +54,23 @@ type Shipping struct {
   Postcode  string `json:"postcode,omitempty"`
   Country   string `json:"country,omitempty"`
   Phone     string `json:"phone,omitempty"`
-}
\ No newline at end of file
+}
+
+// Bool returns a pointer to a bool, for optional fields (eg. PaymentGateway.Enabled)
+func Bool(value bool) *bool {
+  return &value
+}
+
+// SettingOptions maps option keys to their labels. PHP encodes an empty
+// options map as a list, which is decoded as no options.
+type SettingOptions map[string]string
+
+func (options *SettingOptions) UnmarshalJSON(data []byte) error {
+  if len(data) > 0 && data[0] == '[' {
+    *options = nil
+
+    return nil
+  }
+
+  return json.Unmarshal(data, (*map[string]string)(options))
+}
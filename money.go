@@ -0,0 +1,308 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is a decimal amount, kept as its decimal string (eg. "19.99") so that
+// amounts are never rounded by a float conversion. WooCommerce encodes amounts
+// as strings or numbers depending on the field: both are decoded, and amounts
+// are encoded back as strings, which the API accepts for all amount fields.
+//
+// The empty Money is an unset amount (eg. no sale price), and is omitted by
+// omitempty fields. It counts as zero in arithmetic. Invalid amounts, which
+// can only be built by conversion (use ParseMoney to validate), also count as
+// zero.
+type Money string
+
+// Exponent bound of parsed amounts, far above any currency precision
+const maxDecimalExponent = 100
+
+var ten = big.NewInt(10)
+
+// ParseMoney parses a decimal amount (eg. "19.99", "-5", "1e3")
+func ParseMoney(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+
+	if _, _, ok := parseDecimal(value); !ok {
+		return "", fmt.Errorf("invalid amount %q", value)
+	}
+
+	// Exponent notation? (eg. JSON numbers like 1.5E-5)
+	if strings.ContainsAny(value, "eE") {
+		coefficient, scale, _ := parseDecimal(value)
+
+		return formatDecimal(coefficient, scale), nil
+	}
+
+	return Money(value), nil
+}
+
+// NewMoney returns the amount of units in the given scale (eg. NewMoney(1999, 2)
+// is "19.99"), like the minor unit prices of the Store API
+func NewMoney(units int64, scale int) Money {
+	return formatDecimal(big.NewInt(units), scale)
+}
+
+// MoneyFromFloat returns the shortest decimal amount representing a float
+func MoneyFromFloat(value float64) Money {
+	return Money(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// SumMoney returns the sum of amounts
+func SumMoney(amounts ...Money) Money {
+	sum := Money("0")
+	for _, amount := range amounts {
+		sum = sum.Add(amount)
+	}
+
+	return sum
+}
+
+// String returns the decimal string of the amount
+func (money Money) String() string {
+	return string(money)
+}
+
+// IsEmpty reports whether the amount is unset
+func (money Money) IsEmpty() bool {
+	return money == ""
+}
+
+// Valid reports whether the amount is unset or a decimal amount
+func (money Money) Valid() bool {
+	if money == "" {
+		return true
+	}
+
+	_, _, ok := parseDecimal(string(money))
+
+	return ok
+}
+
+// IsZero reports whether the amount is zero (or unset)
+func (money Money) IsZero() bool {
+	return money.Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount
+func (money Money) Sign() int {
+	coefficient, _ := money.decimal()
+
+	return coefficient.Sign()
+}
+
+// Cmp compares two amounts and returns -1, 0 or +1
+func (money Money) Cmp(other Money) int {
+	a, b, _ := alignDecimals(money, other)
+
+	return a.Cmp(b)
+}
+
+// Equal reports whether two amounts are equal, regardless of their number of
+// decimals (eg. "10.5" and "10.50")
+func (money Money) Equal(other Money) bool {
+	return money.Cmp(other) == 0
+}
+
+// Add returns the sum of two amounts, with the decimals of the most precise one
+func (money Money) Add(other Money) Money {
+	a, b, scale := alignDecimals(money, other)
+
+	return formatDecimal(a.Add(a, b), scale)
+}
+
+// Sub returns the difference of two amounts, with the decimals of the most
+// precise one
+func (money Money) Sub(other Money) Money {
+	a, b, scale := alignDecimals(money, other)
+
+	return formatDecimal(a.Sub(a, b), scale)
+}
+
+// Mul returns the exact product of two amounts (eg. a price and a tax rate)
+func (money Money) Mul(other Money) Money {
+	a, scaleA := money.decimal()
+	b, scaleB := other.decimal()
+
+	return formatDecimal(a.Mul(a, b), scaleA+scaleB)
+}
+
+// MulInt returns the amount multiplied by an integer (eg. a quantity)
+func (money Money) MulInt(factor int) Money {
+	coefficient, scale := money.decimal()
+
+	return formatDecimal(coefficient.Mul(coefficient, big.NewInt(int64(factor))), scale)
+}
+
+// Neg returns the opposite amount
+func (money Money) Neg() Money {
+	coefficient, scale := money.decimal()
+
+	return formatDecimal(coefficient.Neg(coefficient), scale)
+}
+
+// Abs returns the absolute amount
+func (money Money) Abs() Money {
+	coefficient, scale := money.decimal()
+
+	return formatDecimal(coefficient.Abs(coefficient), scale)
+}
+
+// Round rounds the amount to dp decimals, half away from zero like WooCommerce
+// (see the dp parameter of the API, and the currency number of decimals).
+// The result has exactly dp decimals (eg. "10.5" rounded to 2 is "10.50").
+func (money Money) Round(dp int) Money {
+	if dp < 0 {
+		dp = 0
+	}
+
+	coefficient, scale := money.decimal()
+
+	if scale <= dp {
+		coefficient.Mul(coefficient, new(big.Int).Exp(ten, big.NewInt(int64(dp-scale)), nil))
+
+		return formatDecimal(coefficient, dp)
+	}
+
+	divisor := new(big.Int).Exp(ten, big.NewInt(int64(scale-dp)), nil)
+	quotient, remainder := new(big.Int).QuoRem(coefficient, divisor, new(big.Int))
+
+	// Half or more? (remainder has the sign of the amount)
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(coefficient.Sign())))
+	}
+
+	return formatDecimal(quotient, dp)
+}
+
+// Float64 returns the nearest float of the amount, for display or statistics
+func (money Money) Float64() float64 {
+	value, _ := strconv.ParseFloat(string(money), 64)
+
+	return value
+}
+
+func (money Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(money))
+}
+
+func (money *Money) UnmarshalJSON(data []byte) error {
+	var value string
+
+	switch {
+	case string(data) == "null":
+		*money = ""
+
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	default:
+		value = string(data)
+	}
+
+	parsed, err := ParseMoney(value)
+	if err != nil {
+		return err
+	}
+
+	*money = parsed
+
+	return nil
+}
+
+// decimal returns the amount as coefficient * 10^-scale, zero when unset or
+// invalid
+func (money Money) decimal() (*big.Int, int) {
+	coefficient, scale, ok := parseDecimal(string(money))
+	if !ok {
+		return new(big.Int), 0
+	}
+
+	return coefficient, scale
+}
+
+// alignDecimals returns the coefficients of two amounts in their common scale
+func alignDecimals(a Money, b Money) (*big.Int, *big.Int, int) {
+	coefficientA, scaleA := a.decimal()
+	coefficientB, scaleB := b.decimal()
+
+	scale := max(scaleA, scaleB)
+
+	coefficientA.Mul(coefficientA, new(big.Int).Exp(ten, big.NewInt(int64(scale-scaleA)), nil))
+	coefficientB.Mul(coefficientB, new(big.Int).Exp(ten, big.NewInt(int64(scale-scaleB)), nil))
+
+	return coefficientA, coefficientB, scale
+}
+
+// parseDecimal parses [-+]digits[.digits][e[-+]digits] into its coefficient
+// and number of decimals
+func parseDecimal(value string) (*big.Int, int, bool) {
+	mantissa, exponent := value, 0
+
+	if index := strings.IndexAny(value, "eE"); index >= 0 {
+		parsed, err := strconv.Atoi(value[index+1:])
+		if err != nil || parsed > maxDecimalExponent || parsed < -maxDecimalExponent {
+			return nil, 0, false
+		}
+
+		mantissa, exponent = value[:index], parsed
+	}
+
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(mantissa, ".")
+	if integer == "" && fraction == "" {
+		return nil, 0, false
+	}
+
+	for _, digit := range integer + fraction {
+		if digit < '0' || digit > '9' {
+			return nil, 0, false
+		}
+	}
+
+	coefficient, ok := new(big.Int).SetString(sign+integer+fraction, 10)
+	if !ok {
+		return nil, 0, false
+	}
+
+	scale := len(fraction) - exponent
+	if scale < 0 {
+		coefficient.Mul(coefficient, new(big.Int).Exp(ten, big.NewInt(int64(-scale)), nil))
+		scale = 0
+	}
+
+	return coefficient, scale, true
+}
+
+// formatDecimal formats coefficient * 10^-scale with exactly scale decimals
+func formatDecimal(coefficient *big.Int, scale int) Money {
+	digits := new(big.Int).Abs(coefficient).String()
+
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+
+	if coefficient.Sign() < 0 {
+		digits = "-" + digits
+	}
+
+	return Money(digits)
+}
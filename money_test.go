@@ -0,0 +1,154 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Money
+		err   bool
+	}{
+		{value: "", want: ""},
+		{value: " 19.99 ", want: "19.99"},
+		{value: "-5", want: "-5"},
+		{value: "1e3", want: "1000"},
+		{value: "1.5E-5", want: "0.000015"},
+		{value: "-2.5e1", want: "-25"},
+		{value: "abc", err: true},
+		{value: "1,99", err: true},
+		{value: ".", err: true},
+		{value: "-", err: true},
+		{value: "1e", err: true},
+		{value: "1e101", err: true},
+		{value: "1.2.3", err: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseMoney(test.value)
+
+		if test.err {
+			if err == nil {
+				t.Errorf("ParseMoney(%q) = %q, want an error", test.value, got)
+			}
+
+			continue
+		}
+
+		if err != nil || got != test.want {
+			t.Errorf("ParseMoney(%q) = %q, %v, want %q", test.value, got, err, test.want)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		got  Money
+		want Money
+	}{
+		{got: NewMoney(1999, 2), want: "19.99"},
+		{got: NewMoney(-5, 2), want: "-0.05"},
+		{got: NewMoney(0, 2), want: "0.00"},
+		{got: NewMoney(42, 0), want: "42"},
+		{got: MoneyFromFloat(0.1), want: "0.1"},
+		{got: MoneyFromFloat(10), want: "10"},
+		{got: Money("10.5").Add("0.25"), want: "10.75"},
+		{got: Money("0.1").Add("0.2"), want: "0.3"},
+		{got: Money("10").Sub("10.01"), want: "-0.01"},
+		{got: Money("19.99").MulInt(3), want: "59.97"},
+		{got: Money("10.00").Mul("0.2"), want: "2.000"},
+		{got: Money("1.5").Neg(), want: "-1.5"},
+		{got: Money("-1.5").Abs(), want: "1.5"},
+		{got: Money("").Add("1.25"), want: "1.25"},
+		{got: SumMoney("1.10", "2.2", "-0.3"), want: "3.00"},
+	}
+
+	for index, test := range tests {
+		if test.got != test.want {
+			t.Errorf("test %d: got %q, want %q", index, test.got, test.want)
+		}
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		amount Money
+		dp     int
+		want   Money
+	}{
+		{amount: "10.5", dp: 2, want: "10.50"},
+		{amount: "10.125", dp: 2, want: "10.13"},
+		{amount: "10.124", dp: 2, want: "10.12"},
+		{amount: "-10.125", dp: 2, want: "-10.13"},
+		{amount: "-10.124", dp: 2, want: "-10.12"},
+		{amount: "0.5", dp: 0, want: "1"},
+		{amount: "-0.5", dp: 0, want: "-1"},
+		{amount: "0.49", dp: 0, want: "0"},
+		{amount: "2.675", dp: 2, want: "2.68"},
+		{amount: "1.005", dp: 2, want: "1.01"},
+		{amount: "12", dp: 2, want: "12.00"},
+		{amount: "9.999", dp: 2, want: "10.00"},
+		{amount: "1.25", dp: -1, want: "1"},
+		{amount: "", dp: 2, want: "0.00"},
+	}
+
+	for _, test := range tests {
+		if got := test.amount.Round(test.dp); got != test.want {
+			t.Errorf("Money(%q).Round(%d) = %q, want %q", test.amount, test.dp, got, test.want)
+		}
+	}
+}
+
+func TestMoneyCompare(t *testing.T) {
+	tests := []struct {
+		a, b Money
+		want int
+	}{
+		{a: "10.5", b: "10.50", want: 0},
+		{a: "10.5", b: "10.49", want: 1},
+		{a: "-1", b: "0", want: -1},
+		{a: "", b: "0.00", want: 0},
+	}
+
+	for _, test := range tests {
+		if got := test.a.Cmp(test.b); got != test.want {
+			t.Errorf("Money(%q).Cmp(%q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	tests := []struct {
+		json string
+		want Money
+	}{
+		{json: `"19.99"`, want: "19.99"},
+		{json: `19.99`, want: "19.99"},
+		{json: `0.1`, want: "0.1"},
+		{json: `1.5E-5`, want: "0.000015"},
+		{json: `null`, want: ""},
+		{json: `""`, want: ""},
+	}
+
+	for _, test := range tests {
+		var got Money
+		if err := json.Unmarshal([]byte(test.json), &got); err != nil || got != test.want {
+			t.Errorf("unmarshal %s = %q, %v, want %q", test.json, got, err, test.want)
+		}
+	}
+
+	var invalid Money
+	if err := json.Unmarshal([]byte(`"12,50"`), &invalid); err == nil {
+		t.Errorf("unmarshal \"12,50\" = %q, want an error", invalid)
+	}
+
+	data, err := json.Marshal(struct {
+		Total Money `json:"total"`
+	}{Total: "19.990"})
+
+	if err != nil || string(data) != `{"total":"19.990"}` {
+		t.Errorf("marshal = %s, %v", data, err)
+	}
+}
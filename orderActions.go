@@ -0,0 +1,124 @@
+package woocommerce
+
+import "net/http"
+
+// OrderEmailTemplate object, an email that can be sent for an order
+type OrderEmailTemplate struct {
+	Id          string `json:"id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// OrderActionResult object, the result of an order action
+type OrderActionResult struct {
+	Message string `json:"message,omitempty"`
+}
+
+type SendOrderDetailsParams struct {
+	Email            string `json:"email,omitempty"`
+	ForceEmailUpdate bool   `json:"force_email_update,omitempty"`
+}
+
+type SendOrderEmailParams struct {
+	TemplateId       string `json:"template_id,omitempty"`
+	Email            string `json:"email,omitempty"`
+	ForceEmailUpdate bool   `json:"force_email_update,omitempty"`
+}
+
+type NotifyCustomerParams struct {
+	// SendOrderDetails also sends the order details (customer invoice)
+	SendOrderDetails bool
+}
+
+const (
+	OrderEmailTemplateCustomerInvoice         = "customer_invoice"
+	OrderEmailTemplateCustomerProcessingOrder = "customer_processing_order"
+	OrderEmailTemplateCustomerCompletedOrder  = "customer_completed_order"
+	OrderEmailTemplateCustomerOnHoldOrder     = "customer_on_hold_order"
+	OrderEmailTemplateCustomerRefundedOrder   = "customer_refunded_order"
+	OrderEmailTemplateCustomerFailedOrder     = "customer_failed_order"
+	OrderEmailTemplateCustomerCancelledOrder  = "customer_cancelled_order"
+	OrderEmailTemplateNewOrder                = "new_order"
+	OrderEmailTemplateCancelledOrder          = "cancelled_order"
+	OrderEmailTemplateFailedOrder             = "failed_order"
+)
+
+// Send the order details (customer invoice) to the customer. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#orders
+func (service *OrdersService) SendOrderDetails(orderId string, opts *SendOrderDetailsParams) (*OrderActionResult, *http.Response, error) {
+	_url := "/orders/" + orderId + "/actions/send_order_details"
+
+	if opts == nil {
+		opts = &SendOrderDetailsParams{}
+	}
+
+	req, err := service.client.NewRequest("POST", _url, nil, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(OrderActionResult)
+	response, err := service.client.Do(req, result)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// List the email templates that can be sent for an order. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#orders
+func (service *OrdersService) ListEmailTemplates(orderId string) ([]OrderEmailTemplate, *http.Response, error) {
+	_url := "/orders/" + orderId + "/actions/email_templates"
+	req, err := service.client.NewRequest("GET", _url, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var templates []OrderEmailTemplate
+	response, err := service.client.Do(req, &templates)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return templates, response, nil
+}
+
+// Send an order email template. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#orders
+func (service *OrdersService) SendEmail(orderId string, opts *SendOrderEmailParams) (*OrderActionResult, *http.Response, error) {
+	_url := "/orders/" + orderId + "/actions/send_email"
+	req, err := service.client.NewRequest("POST", _url, nil, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(OrderActionResult)
+	response, err := service.client.Do(req, result)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// NotifyCustomer adds a customer note to an order, which WooCommerce emails to
+// the customer. With SendOrderDetails, the order details (customer invoice) are
+// sent too, in a second email. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-order-note
+func (service *OrdersService) NotifyCustomer(orderId string, note string, opts *NotifyCustomerParams) (*OrderNote, *OrderActionResult, error) {
+	createdNote, _, err := service.client.OrderNotes.Create(orderId, &OrderNote{Note: note, CustomerNote: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts == nil || !opts.SendOrderDetails {
+		return createdNote, nil, nil
+	}
+
+	result, _, err := service.SendOrderDetails(orderId, nil)
+	if err != nil {
+		return createdNote, nil, err
+	}
+
+	return createdNote, result, nil
+}
@@ -1,6 +1,8 @@
 package woocommerce
 
 import (
+  "errors"
+  "fmt"
   "net/http"
 )
 
@@ -11,18 +13,27 @@ type OrderNotesService service
 type OrderNote struct {
   Id              int         `json:"id,omitempty"`
   Author          string      `json:"author,omitempty"`
-  DateCreated     string      `json:"date_created,omitempty"`
-  DateCreatedGmt  string      `json:"date_created_gmt,omitempty"`
+  DateCreated     *WCTime     `json:"date_created,omitempty"`
+  DateCreatedGmt  *WCTime     `json:"date_created_gmt,omitempty"`
   Note            string      `json:"note,omitempty"`
   CustomerNote    bool        `json:"customer_note,omitempty"`
   AddedByUser     bool        `json:"added_by_user,omitempty"` 
 }
 
 type ListOrderNotesParams struct {
-  Context  string      `json:"context,omitempty"`
-  Type     string      `json:"type,omitempty"`
+  Context  string      `url:"context,omitempty"`
+  Type     string      `url:"type,omitempty"`
 }
 
+const (
+  OrderNoteTypeAny      = "any"
+  OrderNoteTypeCustomer = "customer"
+  OrderNoteTypeInternal = "internal"
+)
+
+// ErrOrderNoteCustomerUpdate is returned when updating a customer note
+var ErrOrderNoteCustomerUpdate = errors.New("customer notes cannot be updated without emailing the customer again")
+
 type DeleteOrderNoteParams struct {
   Force    bool       `url:"force"`
 }
@@ -73,7 +84,7 @@ func (service *OrderNotesService) List(orderId string, opts *ListOrderNotesParam
 }
 
 // Delete an order Note. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-order-note
-func (service *OrderNotesService) Delete(orderId string, noteId string, opts *DeleteOrderParams) (*OrderNote, *http.Response, error) {
+func (service *OrderNotesService) Delete(orderId string, noteId string, opts *DeleteOrderNoteParams) (*OrderNote, *http.Response, error) {
   _url := "/orders/" + orderId + "/notes/" + noteId
   req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
 
@@ -85,4 +96,37 @@ func (service *OrderNotesService) Delete(orderId string, noteId string, opts *De
   }
 
   return orderNote, response, nil
-}
\ No newline at end of file
+}
+
+// Update an order Note. The API does not allow editing notes, so the note is
+// created again with the new content and the previous one is deleted:
+//   - the returned note has a new ID and creation date
+//   - the update is not atomic: when the deletion fails, both notes exist and
+//     the new note is returned with an error
+//   - customer notes are refused (ErrOrderNoteCustomerUpdate), since creating
+//     them emails the customer again
+func (service *OrderNotesService) Update(orderId string, noteId string, orderNote *OrderNote) (*OrderNote, *http.Response, error) {
+  currentNote, response, err := service.Get(orderId, noteId)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  if currentNote.CustomerNote || orderNote.CustomerNote {
+    return nil, response, ErrOrderNoteCustomerUpdate
+  }
+
+  updatedNote, response, err := service.Create(orderId, orderNote)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  _, response, err = service.Delete(orderId, noteId, &DeleteOrderNoteParams{Force: true})
+
+  if err != nil {
+    return updatedNote, response, fmt.Errorf("order note %d created but note %s not deleted: %w", updatedNote.Id, noteId, err)
+  }
+
+  return updatedNote, response, nil
+}
@@ -0,0 +1,102 @@
+package woocommerce
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	OrderTimelineCreated      = "created"
+	OrderTimelinePaid         = "paid"
+	OrderTimelineCompleted    = "completed"
+	OrderTimelineNote         = "note"
+	OrderTimelineCustomerNote = "customer_note"
+	OrderTimelineStatusChange = "status_change"
+	OrderTimelineRefund       = "refund"
+)
+
+// OrderStatusChangeNote matches the order notes recorded by WooCommerce on
+// status changes, with the previous and new status labels as submatches. Notes
+// are written in the store language: replace it with the translated sentence
+// for stores not in English, otherwise their status changes are returned as
+// plain notes.
+var OrderStatusChangeNote = regexp.MustCompile(`^Order status changed from (.+?) to (.+?)\.`)
+
+// OrderTimelineEvent is a single event of an order history
+type OrderTimelineEvent struct {
+	Type       string
+	Date       time.Time
+	Note       *OrderNote
+	Refund     *Refund
+	FromStatus string
+	ToStatus   string
+}
+
+// Timeline returns the history of an order ordered by date: its creation,
+// payment and completion, its notes, status changes (see OrderStatusChangeNote)
+// and refunds. Dates are in UTC.
+func (service *OrdersService) Timeline(orderId string) ([]OrderTimelineEvent, error) {
+	order, _, err := service.Get(orderId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, _, err := service.client.OrderNotes.List(orderId, &ListOrderNotesParams{Type: OrderNoteTypeAny})
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := ListAll[Refund](context.Background(), service.client, service.client.DefaultNamespace(), "/orders/"+orderId+"/refunds", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []OrderTimelineEvent
+
+	addEvent := func(event OrderTimelineEvent, date *WCTime) {
+		if date.IsZero() {
+			return
+		}
+
+		event.Date = date.Time
+		events = append(events, event)
+	}
+
+	addEvent(OrderTimelineEvent{Type: OrderTimelineCreated}, order.DateCreatedGmt)
+	addEvent(OrderTimelineEvent{Type: OrderTimelinePaid}, order.DatePaidGmt)
+	addEvent(OrderTimelineEvent{Type: OrderTimelineCompleted}, order.DateCompletedGmt)
+
+	for i := range *notes {
+		note := &(*notes)[i]
+		event := OrderTimelineEvent{Type: OrderTimelineNote, Note: note}
+
+		if note.CustomerNote {
+			event.Type = OrderTimelineCustomerNote
+		} else if match := OrderStatusChangeNote.FindStringSubmatch(note.Note); len(match) > 2 {
+			event.Type = OrderTimelineStatusChange
+			event.FromStatus = match[1]
+			event.ToStatus = match[2]
+		}
+
+		addEvent(event, note.DateCreatedGmt)
+	}
+
+	for i := range refunds {
+		refund := &refunds[i]
+
+		if refund.ParentId == 0 {
+			refund.ParentId, _ = strconv.Atoi(orderId)
+		}
+
+		addEvent(OrderTimelineEvent{Type: OrderTimelineRefund, Refund: refund}, refund.DateCreatedGmt)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Date.Before(events[j].Date)
+	})
+
+	return events, nil
+}
@@ -23,17 +23,17 @@ type Order struct {
   Version            string           `json:"version,omitempty"`
   Status             string           `json:"status,omitempty"`
   Currency           string           `json:"currency,omitempty"`
-  DateCreated        string           `json:"date_created,omitempty"`
-  DateCreatedGmt     string           `json:"date_created_gmt,omitempty"`
-  DateModified       string           `json:"date_modified,omitempty"`
-  DateModifiedGmt    string           `json:"date_modified_gmt,omitempty"`
-  DiscountTotal      string           `json:"discount_total,omitempty"`
-  DiscountTax        string           `json:"discount_tax,omitempty"`
-  ShippingTotal      string           `json:"shipping_total,omitempty"`
-  ShippingTax        string           `json:"shipping_tax,omitempty"`
-  CartTax            string           `json:"cart_tax,omitempty"`
-  Total              string           `json:"total,omitempty"`
-  TotalTax           string           `json:"total_tax,omitempty"`
+  DateCreated        *WCTime          `json:"date_created,omitempty"`
+  DateCreatedGmt     *WCTime          `json:"date_created_gmt,omitempty"`
+  DateModified       *WCTime          `json:"date_modified,omitempty"`
+  DateModifiedGmt    *WCTime          `json:"date_modified_gmt,omitempty"`
+  DiscountTotal      Money            `json:"discount_total,omitempty"`
+  DiscountTax        Money            `json:"discount_tax,omitempty"`
+  ShippingTotal      Money            `json:"shipping_total,omitempty"`
+  ShippingTax        Money            `json:"shipping_tax,omitempty"`
+  CartTax            Money            `json:"cart_tax,omitempty"`
+  Total              Money            `json:"total,omitempty"`
+  TotalTax           Money            `json:"total_tax,omitempty"`
   CustomerIPAddress  string           `json:"customer_ip_address,omitempty"`
   CustomerUserAgent  string           `json:"customer_user_agent,omitempty"`
   CustomerNote       string           `json:"customer_note,omitempty"`
@@ -41,10 +41,10 @@ type Order struct {
   PaymentMethod      string           `json:"payment_method,omitempty"`
   PaymentMethodTitle string           `json:"payment_method_title,omitempty"`
   TransactionID      string           `json:"transaction_id,omitempty"`
-  DatePaid           string           `json:"date_paid,omitempty"`
-  DatePaidGmt        string           `json:"date_paid_gmt,omitempty"`
-  DateCompleted      string           `json:"date_completed,omitempty"`
-  DateCompletedGmt   string           `json:"date_completed_gmt,omitempty"`
+  DatePaid           *WCTime          `json:"date_paid,omitempty"`
+  DatePaidGmt        *WCTime          `json:"date_paid_gmt,omitempty"`
+  DateCompleted      *WCTime          `json:"date_completed,omitempty"`
+  DateCompletedGmt   *WCTime          `json:"date_completed_gmt,omitempty"`
   CartHash           string           `json:"cart_hash,omitempty"`
   Billing            *Billing         `json:"billing,omitempty"`
   Shipping           *Shipping        `json:"shipping,omitempty"`
@@ -61,14 +61,14 @@ type Order struct {
 type OrderRefund struct {
   ID     int    `json:"id,omitempty"`
   Reason string `json:"reason,omitempty"`
-  Total  string `json:"total,omitempty"`
+  Total  Money  `json:"total,omitempty"`
 }
 
 type CouponLine struct {
   Id            int         `json:"id,omitempty"`
   Code          string      `json:"code,omitempty"`
-  Discount      string      `json:"discount,omitempty"`
-  DiscountTax   string      `json:"discountTax,omitempty"`
+  Discount      Money       `json:"discount,omitempty"`
+  DiscountTax   Money       `json:"discountTax,omitempty"`
   MetaData      *MetaData   `json:"metaData,omitempty"`
 }
 
@@ -77,9 +77,9 @@ type FeeLine struct {
   Name 	      string         `json:"name,omitempty"`
   TaxClass 	  string         `json:"tax_class,omitempty"` 
   TaxStatus 	string         `json:"tax_status,omitempty"`
-  Amount      string         `json:"amount,omitempty"`
-  Total 	    string         `json:"total,omitempty"` 
-  TotalTax 	  string         `json:"total_tax,omitempty"` 
+  Amount      Money          `json:"amount,omitempty"`
+  Total 	    Money          `json:"total,omitempty"` 
+  TotalTax 	  Money          `json:"total_tax,omitempty"` 
   Taxes 	    *[]Taxes       `json:"taxes,omitempty"` 
   MetaData 	  *[]MetaData    `json:"meta_data,omitempty"` 
 }
@@ -90,8 +90,8 @@ type Taxes struct {
   Rate_id 	          string  `json:"rate_id,omitempty"`
   Label 	            string  `json:"label,omitempty"`
   Compound 	          bool    `json:"compound"`
-  TaxTotal 	          string  `json:"tax_total,omitempty"`
-  ShippingTaxTotal 	  string  `json:"shipping_tax_total,omitempty"`
+  TaxTotal 	          Money   `json:"tax_total,omitempty"`
+  ShippingTaxTotal 	  Money   `json:"shipping_tax_total,omitempty"`
 }
 
 type LineItems struct {
@@ -101,14 +101,14 @@ type LineItems struct {
   VariationID int            `json:"variation_id,omitempty"`
   Quantity    int            `json:"quantity,omitempty"`
   TaxClass    string         `json:"tax_class,omitempty"`
-  Subtotal    string         `json:"subtotal,omitempty"`
-  SubtotalTax string         `json:"subtotal_tax,omitempty"`
-  Total       string         `json:"total,omitempty"`
-  TotalTax    string         `json:"total_tax,omitempty"`
+  Subtotal    Money          `json:"subtotal,omitempty"`
+  SubtotalTax Money          `json:"subtotal_tax,omitempty"`
+  Total       Money          `json:"total,omitempty"`
+  TotalTax    Money          `json:"total_tax,omitempty"`
   Taxes       *[]Taxes       `json:"taxes,omitempty"`
   MetaData    *[]MetaData    `json:"meta_data,omitempty"`
   Sku         string         `json:"sku,omitempty"`
-  Price       float64        `json:"price,omitempty"`
+  Price       Money          `json:"price,omitempty"`
   Image       *Image         `json:"image,omitempty"`
   ParentName  interface{}    `json:"parent_name,omitempty"`
 }
@@ -119,8 +119,8 @@ type TaxLines struct {
   RateID           int           `json:"rate_id,omitempty"`
   Label            string        `json:"label,omitempty"`
   Compound         bool          `json:"compound"`
-  TaxTotal         string        `json:"tax_total,omitempty"`
-  ShippingTaxTotal string        `json:"shipping_tax_total,omitempty"`
+  TaxTotal         Money         `json:"tax_total,omitempty"`
+  ShippingTaxTotal Money         `json:"shipping_tax_total,omitempty"`
   RatePercent      float64       `json:"rate_percent"`
   MetaData         *[]interface{} `json:"meta_data,omitempty"`
 }
@@ -130,8 +130,8 @@ type ShippingLines struct {
   MethodTitle string        `json:"method_title,omitempty"`
   MethodID    string        `json:"method_id,omitempty"`
   InstanceID  string        `json:"instance_id,omitempty"`
-  Total       string        `json:"total,omitempty"`
-  TotalTax    string        `json:"total_tax,omitempty"`
+  Total       Money         `json:"total,omitempty"`
+  TotalTax    Money         `json:"total_tax,omitempty"`
   Taxes       *[]interface{} `json:"taxes,omitempty"`
   MetaData    *[]interface{} `json:"meta_data,omitempty"`
 }
@@ -156,6 +156,12 @@ type ListOrdersParams struct {
   Before           string    `url:"before,omitempty"`
   ModifiedAfter    string    `url:"modified_after,omitempty"`
   ModifiedBefore   string    `url:"modified_before,omitempty"`
+  // Dates as time values, sent in UTC: set DatesAreGTM with them. They take
+  // precedence over the string dates.
+  AfterGmt         *WCTime   `url:"after,omitempty"`
+  BeforeGmt        *WCTime   `url:"before,omitempty"`
+  ModifiedAfterGmt *WCTime   `url:"modified_after,omitempty"`
+  ModifiedBeforeGmt *WCTime  `url:"modified_before,omitempty"`
   Status           *[]string `url:"status,omitempty"`
 }
 
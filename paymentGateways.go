@@ -0,0 +1,259 @@
+package woocommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Payment gateways service
+type PaymentGatewaysService service
+
+// PaymentGateway object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#payment-gateway-properties
+type PaymentGateway struct {
+	Id                string                  `json:"id,omitempty"`
+	Title             string                  `json:"title,omitempty"`
+	Description       string                  `json:"description,omitempty"`
+	Order             interface{}             `json:"order,omitempty"`
+	Enabled           *bool                   `json:"enabled,omitempty"`
+	MethodTitle       string                  `json:"method_title,omitempty"`
+	MethodDescription string                  `json:"method_description,omitempty"`
+	MethodSupports    []string                `json:"method_supports,omitempty"`
+	Settings          *PaymentGatewaySettings `json:"settings,omitempty"`
+	Links             *Links                  `json:"_links,omitempty"`
+}
+
+// PaymentGatewaySetting holds a single payment gateway setting
+type PaymentGatewaySetting struct {
+	Id          string         `json:"id,omitempty"`
+	Label       string         `json:"label,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Type        string         `json:"type,omitempty"`
+	Value       interface{}    `json:"value,omitempty"`
+	Default     interface{}    `json:"default,omitempty"`
+	Tip         string         `json:"tip,omitempty"`
+	Placeholder string         `json:"placeholder,omitempty"`
+	Options     SettingOptions `json:"options,omitempty"`
+}
+
+// PaymentGatewaySettings is an ordered map of setting ID to setting. The API
+// returns full setting definitions but only accepts values on writes, so the
+// settings marshal to an ordered map of setting ID to value. Reading nil
+// settings returns no settings.
+type PaymentGatewaySettings struct {
+	ids      []string
+	settings map[string]*PaymentGatewaySetting
+}
+
+var errorPaymentGatewaySettingsNotObject = errors.New("payment gateway settings must be a JSON object")
+
+// Keys returns the setting IDs in the order they were received or added
+func (settings *PaymentGatewaySettings) Keys() []string {
+	if settings == nil {
+		return nil
+	}
+
+	return append([]string(nil), settings.ids...)
+}
+
+// Len returns the number of settings
+func (settings *PaymentGatewaySettings) Len() int {
+	if settings == nil {
+		return 0
+	}
+
+	return len(settings.ids)
+}
+
+// Get returns a setting by ID
+func (settings *PaymentGatewaySettings) Get(id string) (*PaymentGatewaySetting, bool) {
+	if settings == nil {
+		return nil, false
+	}
+
+	setting, ok := settings.settings[id]
+
+	return setting, ok
+}
+
+// Set changes the value of a setting, adding it when it does not exist yet.
+// The settings must not be nil (eg. gateway.Settings = &PaymentGatewaySettings{}).
+func (settings *PaymentGatewaySettings) Set(id string, value interface{}) {
+	if settings.settings == nil {
+		settings.settings = map[string]*PaymentGatewaySetting{}
+	}
+
+	setting, ok := settings.settings[id]
+	if !ok {
+		setting = &PaymentGatewaySetting{Id: id}
+
+		settings.ids = append(settings.ids, id)
+		settings.settings[id] = setting
+	}
+
+	setting.Value = value
+}
+
+// String returns the value of a text-like setting
+func (settings *PaymentGatewaySettings) String(id string) string {
+	setting, ok := settings.Get(id)
+	if !ok {
+		return ""
+	}
+
+	value, _ := setting.Value.(string)
+
+	return value
+}
+
+// Bool returns the value of a checkbox setting ("yes" or "no")
+func (settings *PaymentGatewaySettings) Bool(id string) bool {
+	return settings.String(id) == "yes"
+}
+
+// SetBool changes the value of a checkbox setting
+func (settings *PaymentGatewaySettings) SetBool(id string, value bool) {
+	if value {
+		settings.Set(id, "yes")
+	} else {
+		settings.Set(id, "no")
+	}
+}
+
+func (settings PaymentGatewaySettings) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('{')
+
+	for i, id := range settings.ids {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(id)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(settings.settings[id].Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (settings *PaymentGatewaySettings) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	// PHP encodes empty settings as a list
+	if delim, ok := token.(json.Delim); ok && delim == '[' {
+		*settings = PaymentGatewaySettings{}
+
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return errorPaymentGatewaySettingsNotObject
+	}
+
+	*settings = PaymentGatewaySettings{settings: map[string]*PaymentGatewaySetting{}}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		id, _ := token.(string)
+
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return err
+		}
+
+		setting := &PaymentGatewaySetting{Id: id}
+
+		// Setting definition, or plain value? (eg. a setting echoed back from a write)
+		if len(raw) > 0 && raw[0] == '{' {
+			err = json.Unmarshal(raw, setting)
+		} else {
+			err = json.Unmarshal(raw, &setting.Value)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, exists := settings.settings[id]; !exists {
+			settings.ids = append(settings.ids, id)
+		}
+
+		settings.settings[id] = setting
+	}
+
+	return nil
+}
+
+// Get a payment gateway. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-payment-gateway
+func (service *PaymentGatewaysService) Get(gatewayID string) (*PaymentGateway, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/payment_gateways/"+gatewayID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gateway := new(PaymentGateway)
+	response, err := service.client.Do(req, gateway)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return gateway, response, nil
+}
+
+// List all payment gateways. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-payment-gateways
+func (service *PaymentGatewaysService) List() ([]PaymentGateway, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/payment_gateways", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gateways []PaymentGateway
+	response, err := service.client.Do(req, &gateways)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return gateways, response, nil
+}
+
+// Update a payment gateway. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-payment-gateway
+func (service *PaymentGatewaysService) Update(gatewayID string, gateway *PaymentGateway) (*PaymentGateway, *http.Response, error) {
+	req, err := service.client.NewRequest("PUT", "/payment_gateways/"+gatewayID, nil, gateway)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedGateway := new(PaymentGateway)
+	response, err := service.client.Do(req, updatedGateway)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return updatedGateway, response, nil
+}
@@ -0,0 +1,258 @@
+package woocommerce
+
+import (
+	"errors"
+	"fmt"
+)
+
+const productCloneBatchSize = 100
+
+var errorCloneProductWithoutSku = errors.New("a SKU scheme is required to clone products with SKUs in the same store")
+
+// CloneProductOptions configures ProductsService.Clone
+type CloneProductOptions struct {
+	// Target is the store to create the clone in, the source store when nil.
+	// Category, tag and attribute IDs are copied as is, use Prepare to map
+	// them to the target store.
+	Target *Client
+
+	// Sku returns the SKU of the cloned product or variation from the source
+	// SKU. Required when cloning products with SKUs in the same store, as
+	// SKUs must be unique.
+	Sku func(sku string) string
+
+	// Prepare can change the product (eg. name, status or category IDs of
+	// another store) before it is created
+	Prepare func(product *Product)
+
+	// PrepareVariation can change a variation before it is created
+	PrepareVariation func(variation *ProductVariation)
+}
+
+// Clone copies a product and all of its variations, in the same store or in
+// another one. Unlike Duplicate, the clone is built client side so that SKUs
+// can be renamed and other stores targeted. When a variation cannot be
+// created, the cloned product is deleted.
+func (service *ProductsService) Clone(productID int, opts *CloneProductOptions) (*Product, []ProductVariation, error) {
+	if opts == nil {
+		opts = &CloneProductOptions{}
+	}
+
+	target := opts.Target
+	if target == nil {
+		target = service.client
+	}
+
+	sameStore := target == service.client
+
+	source, _, err := service.Get(productID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variations, err := service.listAllVariations(productID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cloneSku := func(sku string) (string, error) {
+		if sku == "" {
+			return "", nil
+		}
+
+		if opts.Sku != nil {
+			return opts.Sku(sku), nil
+		}
+
+		if sameStore {
+			return "", errorCloneProductWithoutSku
+		}
+
+		return sku, nil
+	}
+
+	// Prepare the product and its variations first, so that SKU errors are
+	// reported before anything is created
+	product := cloneProduct(source, !sameStore)
+
+	if product.Sku, err = cloneSku(source.Sku); err != nil {
+		return nil, nil, err
+	}
+
+	if opts.Prepare != nil {
+		opts.Prepare(product)
+	}
+
+	clones := make([]ProductVariation, 0, len(variations))
+
+	for i := range variations {
+		variation := cloneProductVariation(&variations[i], !sameStore)
+
+		if variation.Sku, err = cloneSku(variations[i].Sku); err != nil {
+			return nil, nil, err
+		}
+
+		if opts.PrepareVariation != nil {
+			opts.PrepareVariation(variation)
+		}
+
+		clones = append(clones, *variation)
+	}
+
+	createdProduct, _, err := target.Products.Create(product)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Create its variations, in batches
+	var createdVariations []ProductVariation
+
+	for start := 0; start < len(clones); start += productCloneBatchSize {
+		end := min(start+productCloneBatchSize, len(clones))
+		batch := clones[start:end]
+
+		result, _, err := target.ProductVariations.Batch(createdProduct.Id, &BatchProductVariationUpdate{Create: &batch})
+		if err != nil {
+			return nil, nil, deleteClonedProduct(target, createdProduct.Id, err)
+		}
+
+		if result.Create != nil {
+			createdVariations = append(createdVariations, *result.Create...)
+		}
+	}
+
+	// Batch requests report item errors per item (eg. a duplicate SKU), make
+	// sure all were created
+	for _, variation := range createdVariations {
+		if variation.Id == 0 {
+			return nil, nil, deleteClonedProduct(target, createdProduct.Id, fmt.Errorf("could not create a variation of product %d", createdProduct.Id))
+		}
+	}
+
+	if len(createdVariations) != len(clones) {
+		err := fmt.Errorf("created %d of %d variations of product %d", len(createdVariations), len(clones), createdProduct.Id)
+
+		return nil, nil, deleteClonedProduct(target, createdProduct.Id, err)
+	}
+
+	return createdProduct, createdVariations, nil
+}
+
+// deleteClonedProduct deletes a partially cloned product, with its variations,
+// and returns the clone error
+func deleteClonedProduct(target *Client, productID int, cloneErr error) error {
+	_, _, err := target.Products.Delete(productID, &DeleteProductParams{Force: "true"})
+	if err != nil {
+		return fmt.Errorf("%w (partially cloned product %d could not be deleted: %v)", cloneErr, productID, err)
+	}
+
+	return cloneErr
+}
+
+func (service *ProductsService) listAllVariations(productID int) ([]ProductVariation, error) {
+	var variations []ProductVariation
+
+	for page := 1; ; page++ {
+		pageVariations, _, err := service.client.ProductVariations.List(productID, &ListProductVariationParams{Page: page, PerPage: productCloneBatchSize})
+		if err != nil {
+			return nil, err
+		}
+
+		variations = append(variations, pageVariations...)
+
+		if len(pageVariations) < productCloneBatchSize {
+			return variations, nil
+		}
+	}
+}
+
+// cloneProduct copies the writable fields of a product
+func cloneProduct(source *Product, otherStore bool) *Product {
+	product := *source
+
+	product.Id = 0
+	product.Slug = ""
+	product.Permalink = ""
+	product.DateCreated = nil
+	product.DateCreatedGmt = nil
+	product.DateModified = nil
+	product.DateModifiedGmt = nil
+	product.Price = ""
+	product.PriceHtml = ""
+	product.TotalSales = 0
+	product.AverageRating = ""
+	product.RatingCount = 0
+	product.Variations = nil
+	product.RelatedIds = nil
+	product.MetaData = cloneMetaData(source.MetaData)
+	product.Images = cloneImages(source.Images, otherStore)
+
+	// Grouped, upsell and cross-sell products IDs belong to the source store
+	if otherStore {
+		product.GroupedProducts = nil
+		product.UpsellIds = nil
+		product.CrossSellIds = nil
+	}
+
+	return &product
+}
+
+// cloneProductVariation copies the writable fields of a variation
+func cloneProductVariation(source *ProductVariation, otherStore bool) *ProductVariation {
+	variation := *source
+
+	variation.Id = 0
+	variation.Permalink = ""
+	variation.DateCreated = nil
+	variation.DateCreatedGmt = nil
+	variation.DateModified = nil
+	variation.DateModifiedGmt = nil
+	variation.Price = ""
+	variation.MetaData = cloneMetaData(source.MetaData)
+
+	if source.Image != nil {
+		images := cloneImages(&[]Image{*source.Image}, otherStore)
+		variation.Image = &(*images)[0]
+	}
+
+	return &variation
+}
+
+// cloneMetaData copies meta data without their IDs, so they are created rather than updated
+func cloneMetaData(source *[]MetaData) *[]MetaData {
+	if source == nil {
+		return nil
+	}
+
+	metaData := make([]MetaData, len(*source))
+	for i, meta := range *source {
+		meta.ID = 0
+		metaData[i] = meta
+	}
+
+	return &metaData
+}
+
+// cloneImages copies images, dropping their IDs for another store so they are
+// uploaded from their source URL
+func cloneImages(source *[]Image, otherStore bool) *[]Image {
+	if source == nil {
+		return nil
+	}
+
+	images := make([]Image, len(*source))
+	for i, image := range *source {
+		image.DateCreated = nil
+		image.DateCreatedGmt = nil
+		image.DateModified = nil
+		image.DateModifiedGmt = nil
+
+		if otherStore {
+			image.Id = nil
+		}
+
+		images[i] = image
+	}
+
+	return &images
+}
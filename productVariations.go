@@ -10,20 +10,20 @@ type ProductVariationService service
 // ProductVariation object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-properties
 type ProductVariation struct {
 	Id                int                  `json:"id,omitempty"`
-	DateCreated       string               `json:"date_created,omitempty"`
-	DateCreatedGmt    string               `json:"date_created_gmt,omitempty"`
-	DateModified      string               `json:"date_modified,omitempty"`
-	DateModifiedGmt   string               `json:"date_modified_gmt,omitempty"`
+	DateCreated       *WCTime              `json:"date_created,omitempty"`
+	DateCreatedGmt    *WCTime              `json:"date_created_gmt,omitempty"`
+	DateModified      *WCTime              `json:"date_modified,omitempty"`
+	DateModifiedGmt   *WCTime              `json:"date_modified_gmt,omitempty"`
 	Description       string               `json:"description,omitempty"`
 	Permalink         string               `json:"permalink,omitempty"`
 	Sku               string               `json:"sku,omitempty"`
-	Price             string               `json:"price,omitempty"`
-	RegularPrice      string               `json:"regular_price,omitempty"`
-	SalePrice         string               `json:"sale_price,omitempty"`
-	DateOnSaleFrom    string               `json:"date_on_sale_from,omitempty"`
-	DateOnSaleFromGmt string               `json:"date_on_sale_from_gmt,omitempty"`
-	DateOnSaleTo      string               `json:"date_on_sale_to,omitempty"`
-	DateOnSaleToGmt   string               `json:"date_on_sale_to_gmt,omitempty"`
+	Price             Money                `json:"price,omitempty"`
+	RegularPrice      Money                `json:"regular_price,omitempty"`
+	SalePrice         Money                `json:"sale_price,omitempty"`
+	DateOnSaleFrom    *WCTime              `json:"date_on_sale_from,omitempty"`
+	DateOnSaleFromGmt *WCTime              `json:"date_on_sale_from_gmt,omitempty"`
+	DateOnSaleTo      *WCTime              `json:"date_on_sale_to,omitempty"`
+	DateOnSaleToGmt   *WCTime              `json:"date_on_sale_to_gmt,omitempty"`
 	OnSale            bool                 `json:"on_sale,omitempty"`
 	Status            string               `json:"status,omitempty"`
 	Purchasable       bool                 `json:"purchasable,omitempty"`
@@ -51,31 +51,31 @@ type ProductVariation struct {
 }
 
 type ListProductVariationParams struct {
-	Context       string `url:"context,omitempty"`
-	Page          int    `url:"page,omitempty"`
-	PerPage       int    `url:"per_page,omitempty"`
-	Search        string `url:"search,omitempty"`
+	Context       string    `url:"context,omitempty"`
+	Page          int       `url:"page,omitempty"`
+	PerPage       int       `url:"per_page,omitempty"`
+	Search        string    `url:"search,omitempty"`
 	After         string `url:"after,omitempty"`
 	Before        string `url:"before,omitempty"`
-	Exclude       *[]int `url:"exclude,omitempty"`
-	Include       *[]int `url:"include,omitempty"`
-	Offset        int    `url:"offset,omitempty"`
-	Order         string `url:"order,omitempty"`
-	OrderBy       string `url:"orderby,omitempty"`
-	Parent        *[]int `url:"parent,omitempty"`
-	ParentExclude *[]int `url:"parent_exclude,omitempty"`
-	Slug          string `url:"slug,omitempty"`
-	Status        string `url:"status,omitempty"`
-	IncludeStatus string `url:"include_status,omitempty"`
-	ExcludeStatus string `url:"exclude_status,omitempty"`
-	Sku           string `url:"sku,omitempty"`
-	TaxClass      string `url:"tax_class,omitempty"`
-	OnSale        bool   `url:"on_sale,omitempty"`
-	MinPrice      string `url:"min_price,omitempty"`
-	MaxPrice      string `url:"max_price,omitempty"`
-	StockStatus   string `url:"stock_status,omitempty"`
-	Virtual       bool   `url:"virtual,omitempty"`
-	Downloadable  bool   `url:"downloadable,omitempty"`
+	Exclude       *[]int    `url:"exclude,omitempty"`
+	Include       *[]int    `url:"include,omitempty"`
+	Offset        int       `url:"offset,omitempty"`
+	Order         string    `url:"order,omitempty"`
+	OrderBy       string    `url:"orderby,omitempty"`
+	Parent        *[]int    `url:"parent,omitempty"`
+	ParentExclude *[]int    `url:"parent_exclude,omitempty"`
+	Slug          string    `url:"slug,omitempty"`
+	Status        string    `url:"status,omitempty"`
+	IncludeStatus string    `url:"include_status,omitempty"`
+	ExcludeStatus string    `url:"exclude_status,omitempty"`
+	Sku           string    `url:"sku,omitempty"`
+	TaxClass      string    `url:"tax_class,omitempty"`
+	OnSale        bool      `url:"on_sale,omitempty"`
+	MinPrice      Money     `url:"min_price,omitempty"`
+	MaxPrice      Money     `url:"max_price,omitempty"`
+	StockStatus   string    `url:"stock_status,omitempty"`
+	Virtual       bool      `url:"virtual,omitempty"`
+	Downloadable  bool      `url:"downloadable,omitempty"`
 }
 
 type DeleteProductVariationParams struct {
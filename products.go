@@ -14,10 +14,10 @@ type Product struct {
 	Name              string               `json:"name,omitempty"`
 	Slug              string               `json:"slug,omitempty"`
 	Permalink         string               `json:"permalink,omitempty"`
-	DateCreated       string               `json:"date_created,omitempty"`
-	DateCreatedGmt    string               `json:"date_created_gmt,omitempty"`
-	DateModified      string               `json:"date_modified,omitempty"`
-	DateModifiedGmt   string               `json:"date_modified_gmt,omitempty"`
+	DateCreated       *WCTime              `json:"date_created,omitempty"`
+	DateCreatedGmt    *WCTime              `json:"date_created_gmt,omitempty"`
+	DateModified      *WCTime              `json:"date_modified,omitempty"`
+	DateModifiedGmt   *WCTime              `json:"date_modified_gmt,omitempty"`
 	Type              string               `json:"type,omitempty"`
 	Status            string               `json:"status,omitempty"`
 	Featured          bool                 `json:"featured,omitempty"`
@@ -25,13 +25,13 @@ type Product struct {
 	Description       string               `json:"description,omitempty"`
 	ShortDescription  string               `json:"short_description,omitempty"`
 	Sku               string               `json:"sku,omitempty"`
-	Price             string               `json:"price,omitempty"`
-	RegularPrice      string               `json:"regular_price,omitempty"`
-	SalePrice         string               `json:"sale_price,omitempty"`
-	DateOnSaleFrom    string               `json:"date_on_sale_from,omitempty"`
-	DateOnSaleFromGmt string               `json:"date_on_sale_from_gmt,omitempty"`
-	DateOnSaleTo      string               `json:"date_on_sale_to,omitempty"`
-	DateOnSaleToGmt   string               `json:"date_on_sale_to_gmt,omitempty"`
+	Price             Money                `json:"price,omitempty"`
+	RegularPrice      Money                `json:"regular_price,omitempty"`
+	SalePrice         Money                `json:"sale_price,omitempty"`
+	DateOnSaleFrom    *WCTime              `json:"date_on_sale_from,omitempty"`
+	DateOnSaleFromGmt *WCTime              `json:"date_on_sale_from_gmt,omitempty"`
+	DateOnSaleTo      *WCTime              `json:"date_on_sale_to,omitempty"`
+	DateOnSaleToGmt   *WCTime              `json:"date_on_sale_to_gmt,omitempty"`
 	PriceHtml         string               `json:"price_html,omitempty"`
 	OnSale            bool                 `json:"on_sale,omitempty"`
 	Purchasable       bool                 `json:"purchasable,omitempty"`
@@ -64,7 +64,7 @@ type Product struct {
 	MenuOrder         int                  `json:"menu_order,omitempty"`
 	Variations        *[]int               `json:"variations,omitempty"`
 	GroupedProducts   *[]int               `json:"grouped_products,omitempty"`
-	MetaData          *[]int               `json:"meta_data,omitempty"`
+	MetaData          *[]MetaData          `json:"meta_data,omitempty"`
 	RelatedIds        *[]int               `json:"related_ids,omitempty"`
 	CrossSellIds      *[]int               `json:"cross_sell_ids,omitempty"`
 	UpsellIds         *[]int               `json:"upsell_ids,omitempty"`
@@ -97,10 +97,10 @@ type ProductCategory struct {
 
 type Image struct {
 	Id              interface{} `json:"id,omitempty"`
-	DateCreated     string      `json:"date_created,omitempty"`
-	DateCreatedGmt  string      `json:"date_created_gmt,omitempty"`
-	DateModified    string      `json:"date_modified,omitempty"`
-	DateModifiedGmt string      `json:"date_modified_gmt,omitempty"`
+	DateCreated     *WCTime     `json:"date_created,omitempty"`
+	DateCreatedGmt  *WCTime     `json:"date_created_gmt,omitempty"`
+	DateModified    *WCTime     `json:"date_modified,omitempty"`
+	DateModifiedGmt *WCTime     `json:"date_modified_gmt,omitempty"`
 	Source          string      `json:"src,omitempty"`
 	Name            string      `json:"name,omitempty"`
 	Alt             string      `json:"alt,omitempty"`
@@ -113,6 +113,7 @@ type ProductAttributes struct {
 	Visible   bool     `json:"visible,omitempty"`
 	Variation bool     `json:"variation,omitempty"`
 	Options   []string `json:"options,omitempty"`
+	Option    string   `json:"option,omitempty"` // Set on variation attributes
 }
 
 type DefaultAttributes struct {
@@ -149,15 +150,22 @@ type ListProductParams struct {
 	AttributeTerm  string `url:"attribute_term,omitempty"`
 	TaxClass       string `url:"tax_class,omitempty"`
 	OnSale         bool   `url:"on_sale,omitempty"`
-	MinPrice       string `url:"min_price,omitempty"`
-	MaxPrice       string `url:"max_price,omitempty"`
+	MinPrice       Money  `url:"min_price,omitempty"`
+	MaxPrice       Money  `url:"max_price,omitempty"`
 	StockStatus    string `url:"stock_status,omitempty"`
 	Parent         *[]int `url:"parent,omitempty"`
 	ParentExclude  *[]int `url:"parent_exclude,omitempty"`
+
+	// Dates as time values, sent in UTC: set DatesAreGmt with them. They take
+	// precedence over the string dates.
+	AfterGmt          *WCTime `url:"after,omitempty"`
+	BeforeGmt         *WCTime `url:"before,omitempty"`
+	ModifiedAfterGmt  *WCTime `url:"modified_after,omitempty"`
+	ModifiedBeforeGmt *WCTime `url:"modified_before,omitempty"`
 }
 
 type DeleteProductParams struct {
-	Force string `json:"force,omitempty"`
+	Force string `url:"force,omitempty"`
 }
 
 type BatchProductUpdate struct {
@@ -261,3 +269,18 @@ func (service *ProductsService) Batch(opts *BatchProductUpdate) (*BatchProductUp
 
 	return products, response, nil
 }
+
+// Duplicate a product with its variations, the duplicate is created as a draft
+func (service *ProductsService) Duplicate(productID int) (*Product, *http.Response, error) {
+	_url := "/products/" + strconv.Itoa(productID) + "/duplicate"
+	req, _ := service.client.NewRequest("POST", _url, nil, nil)
+
+	duplicatedProduct := new(Product)
+	response, err := service.client.Do(req, duplicatedProduct)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return duplicatedProduct, response, nil
+}
@@ -2,6 +2,8 @@ package woocommerce
 
 import (
   "net/http"
+  "path"
+  "strconv"
 )
 
 // Refunds service
@@ -10,15 +12,17 @@ type RefundsService service
 // Refund object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#order-refund-properties
 type Refund struct {
   Id               int               `json:"id,omitempty"`
-  DateCreated      string            `json:"date_created,omitempty"`
-  DateCreatedGmt   string            `json:"date_created_gmt,omitempty"`
-  Amount           string            `json:"amount,omitempty"`
+  ParentId         int               `json:"parent_id,omitempty"`
+  DateCreated      *WCTime           `json:"date_created,omitempty"`
+  DateCreatedGmt   *WCTime           `json:"date_created_gmt,omitempty"`
+  Amount           Money             `json:"amount,omitempty"`
   Reason           string            `json:"reason,omitempty"`
   RefundedBy       int               `json:"refunded_by,omitempty"`
   RefundedPayment  bool              `json:"refunded_payment,omitempty"`
   ApiRefund        bool              `json:"api_refund,omitempty"`
   MetaData         *[]MetaData       `json:"meta_data,omitempty"`
   LineItems        *[]RefundLineItem `json:"line_items,omitempty"`
+  Links            *Links            `json:"_links,omitempty"`
 }
 
 type RefundLineItem struct {
@@ -28,22 +32,22 @@ type RefundLineItem struct {
   VariationId  int          `json:"variation_id,omitempty"`
   Quantity     int          `json:"quantity,omitempty"`
   TaxClass     int          `json:"tax_class,omitempty"`
-  Subtotal     string       `json:"subtotal,omitempty"`
-  SubtotalTax  string       `json:"subtotal_tax,omitempty"`
-  Total        string       `json:"total,omitempty"`
-  TotalTax     string       `json:"total_tax,omitempty"`
+  Subtotal     Money        `json:"subtotal,omitempty"`
+  SubtotalTax  Money        `json:"subtotal_tax,omitempty"`
+  Total        Money        `json:"total,omitempty"`
+  TotalTax     Money        `json:"total_tax,omitempty"`
   Sku          string       `json:"sku,omitempty"`
-  Price        string       `json:"price,omitempty"`
-  RefundTotal  float64      `json:"refund_total,omitempty"`
+  Price        Money        `json:"price,omitempty"`
+  RefundTotal  Money        `json:"refund_total,omitempty"`
   Taxes        *[]RefundTax `json:"taxes,omitempty"`
   MetaData     *[]MetaData  `json:"meta_data,omitempty"`
 }
 
 type RefundTax struct {
   Id           int         `json:"id,omitempty"`
-  Total        string      `json:"total,omitempty"`
-  Subtotal     string      `json:"subtotal,omitempty"`
-  RefundTotal  float64     `json:"refund_total,omitempty"`
+  Total        Money       `json:"total,omitempty"`
+  Subtotal     Money       `json:"subtotal,omitempty"`
+  RefundTotal  Money       `json:"refund_total,omitempty"`
 }
 
 type ListRefundParams struct {
@@ -64,6 +68,32 @@ type ListRefundParams struct {
   Dp             int         `url:"dp,omitempty"`
 }
 
+type ListAllRefundsParams struct {
+  Context        string      `url:"context,omitempty"`
+  Page           int         `url:"page,omitempty"`
+  PerPage        int         `url:"per_page,omitempty"`
+  Search         string      `url:"search,omitempty"`
+  Exclude        *[]int      `url:"exclude,omitempty"`
+  Include        *[]int      `url:"include,omitempty"`
+  Offset         int         `url:"offset,omitempty"`
+  Order          string      `url:"order,omitempty"`
+  OrderBy        string      `url:"orderby,omitempty"`
+  After          string      `url:"after,omitempty"`
+  Before         string      `url:"before,omitempty"`
+  ModifiedAfter  string      `url:"modified_after,omitempty"`
+  ModifiedBefore string      `url:"modified_before,omitempty"`
+  // Dates as time values, sent in UTC: set DatesAreGmt with them. They take
+  // precedence over the string dates.
+  AfterGmt          *WCTime   `url:"after,omitempty"`
+  BeforeGmt         *WCTime   `url:"before,omitempty"`
+  ModifiedAfterGmt  *WCTime   `url:"modified_after,omitempty"`
+  ModifiedBeforeGmt *WCTime   `url:"modified_before,omitempty"`
+  DatesAreGmt    bool        `url:"dates_are_gmt,omitempty"`
+  Parent         *[]int      `url:"parent,omitempty"`
+  ParentExclude  *[]int      `url:"parent_exclude,omitempty"`
+  Dp             int         `url:"dp,omitempty"`
+}
+
 type DeleteRefundParams struct {
   Force    bool       `url:"force"`
 }
@@ -126,4 +156,29 @@ func (service *RefundsService) Delete(orderId string, refundId string, opts *Del
   }
 
   return refund, response, nil
+}
+
+// List refunds of all orders, each refund holds its order ID in ParentId.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-refunds
+func (service *RefundsService) ListAll(opts *ListAllRefundsParams) (*[]Refund, *http.Response, error) {
+  _url := "/refunds"
+  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+
+  refunds := new([]Refund)
+  response, err := service.client.Do(req, refunds)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  // Older stores omit parent_id, read it from the order ("up") link instead
+  for i := range *refunds {
+    refund := &(*refunds)[i]
+
+    if refund.ParentId == 0 && refund.Links != nil && len(refund.Links.Up) > 0 {
+      refund.ParentId, _ = strconv.Atoi(path.Base(refund.Links.Up[0].Href))
+    }
+  }
+
+  return refunds, response, nil
 }
\ No newline at end of file
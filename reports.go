@@ -0,0 +1,207 @@
+package woocommerce
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Reports service
+type ReportsService service
+
+// Report object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-reports
+type Report struct {
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	Links       *Links `json:"_links,omitempty"`
+}
+
+// SalesReport object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#sales-report-properties
+type SalesReport struct {
+	TotalSales      Money                       `json:"total_sales,omitempty"`
+	NetSales        Money                       `json:"net_sales,omitempty"`
+	AverageSales    Money                       `json:"average_sales,omitempty"`
+	TotalOrders     int                         `json:"total_orders,omitempty"`
+	TotalItems      int                         `json:"total_items,omitempty"`
+	TotalTax        Money                       `json:"total_tax,omitempty"`
+	TotalShipping   Money                       `json:"total_shipping,omitempty"`
+	TotalRefunds    Money                       `json:"total_refunds,omitempty"`
+	TotalDiscount   Money                       `json:"total_discount,omitempty"`
+	TotalsGroupedBy string                      `json:"totals_grouped_by,omitempty"`
+	Totals          map[string]SalesReportTotal `json:"totals,omitempty"`
+	TotalCustomers  int                         `json:"total_customers,omitempty"`
+	Links           *Links                      `json:"_links,omitempty"`
+}
+
+// SalesReportTotal holds the sales totals of a single period of a sales report
+type SalesReportTotal struct {
+	Sales     Money `json:"sales,omitempty"`
+	Orders    int   `json:"orders,omitempty"`
+	Items     int   `json:"items,omitempty"`
+	Tax       Money `json:"tax,omitempty"`
+	Shipping  Money `json:"shipping,omitempty"`
+	Discount  Money `json:"discount,omitempty"`
+	Customers int   `json:"customers,omitempty"`
+}
+
+// SalesReportPoint is a dated entry of a sales report series
+type SalesReportPoint struct {
+	Date time.Time
+	SalesReportTotal
+}
+
+// TopSellersReport object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#top-sellers-report-properties
+type TopSellersReport struct {
+	Title     string `json:"title,omitempty"`
+	ProductId int    `json:"product_id,omitempty"`
+	Quantity  int    `json:"quantity,omitempty"`
+	Links     *Links `json:"_links,omitempty"`
+}
+
+// ReportTotal holds a single entry of the orders, products, customers, coupons
+// and reviews totals reports
+type ReportTotal struct {
+	Slug  string `json:"slug,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Total int    `json:"total,omitempty"`
+}
+
+type ReportParams struct {
+	Context string    `url:"context,omitempty"`
+	Period  string    `url:"period,omitempty"`
+	DateMin string `url:"date_min,omitempty"`
+	DateMax string `url:"date_max,omitempty"`
+}
+
+const (
+	ReportPeriodWeek      = "week"
+	ReportPeriodMonth     = "month"
+	ReportPeriodLastMonth = "last_month"
+	ReportPeriodYear      = "year"
+)
+
+// Date layout of the report date_min and date_max parameters
+const ReportDateLayout = "2006-01-02"
+
+// Series returns the report totals ordered by date. Totals are keyed by day
+// ("2006-01-02") or by month ("2006-01") depending on the report period.
+func (report *SalesReport) Series() []SalesReportPoint {
+	series := make([]SalesReportPoint, 0, len(report.Totals))
+
+	for key, total := range report.Totals {
+		date, err := time.Parse(ReportDateLayout, key)
+		if err != nil {
+			date, err = time.Parse("2006-01", key)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		series = append(series, SalesReportPoint{Date: date, SalesReportTotal: total})
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Date.Before(series[j].Date)
+	})
+
+	return series
+}
+
+// List all reports. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-reports
+func (service *ReportsService) List() ([]Report, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/reports", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reports []Report
+	response, err := service.client.Do(req, &reports)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return reports, response, nil
+}
+
+// Get the sales report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-sales-report
+func (service *ReportsService) Sales(opts *ReportParams) (*SalesReport, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/reports/sales", opts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reports []SalesReport
+	response, err := service.client.Do(req, &reports)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	// The sales report is returned as a single item list
+	report := new(SalesReport)
+	if len(reports) > 0 {
+		report = &reports[0]
+	}
+
+	return report, response, nil
+}
+
+// Get the top sellers report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-top-sellers-report
+func (service *ReportsService) TopSellers(opts *ReportParams) ([]TopSellersReport, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/reports/top_sellers", opts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sellers []TopSellersReport
+	response, err := service.client.Do(req, &sellers)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return sellers, response, nil
+}
+
+// Get the orders totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-orders-totals
+func (service *ReportsService) OrdersTotals() ([]ReportTotal, *http.Response, error) {
+	return service.totals("orders")
+}
+
+// Get the products totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-products-totals
+func (service *ReportsService) ProductsTotals() ([]ReportTotal, *http.Response, error) {
+	return service.totals("products")
+}
+
+// Get the customers totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-customers-totals
+func (service *ReportsService) CustomersTotals() ([]ReportTotal, *http.Response, error) {
+	return service.totals("customers")
+}
+
+// Get the coupons totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-coupons-totals
+func (service *ReportsService) CouponsTotals() ([]ReportTotal, *http.Response, error) {
+	return service.totals("coupons")
+}
+
+// Get the reviews totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-reviews-totals
+func (service *ReportsService) ReviewsTotals() ([]ReportTotal, *http.Response, error) {
+	return service.totals("reviews")
+}
+
+func (service *ReportsService) totals(resource string) ([]ReportTotal, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/reports/"+resource+"/totals", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var totals []ReportTotal
+	response, err := service.client.Do(req, &totals)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return totals, response, nil
+}
@@ -0,0 +1,291 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Settings service
+type SettingsService service
+
+// SettingGroup object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#setting-group-properties
+type SettingGroup struct {
+	Id          string   `json:"id,omitempty"`
+	Label       string   `json:"label,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ParentId    string   `json:"parent_id,omitempty"`
+	SubGroups   []string `json:"sub_groups,omitempty"`
+	Links       *Links   `json:"_links,omitempty"`
+}
+
+// SettingOption object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#setting-option-properties
+//
+// Value is decoded according to the declared Type: checkbox settings hold a
+// bool, number settings a float64, multiselect settings a []string and text
+// like settings a string. Other types, and values not matching their type
+// (eg. a non-numeric number setting stored by an extension), hold the plain
+// decoded JSON value.
+type SettingOption struct {
+	Id          string         `json:"id,omitempty"`
+	Label       string         `json:"label,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Value       interface{}    `json:"value"`
+	Default     interface{}    `json:"default,omitempty"`
+	Tip         string         `json:"tip,omitempty"`
+	Placeholder string         `json:"placeholder,omitempty"`
+	Type        string         `json:"type,omitempty"`
+	Options     SettingOptions `json:"options,omitempty"`
+	GroupId     string         `json:"group_id,omitempty"`
+	Links       *Links         `json:"_links,omitempty"`
+
+	// Error is set on failed items of batch responses
+	Error *BatchItemError `json:"error,omitempty"`
+}
+
+type settingOption SettingOption
+
+const (
+	SettingTypeText                 = "text"
+	SettingTypeEmail                = "email"
+	SettingTypeNumber               = "number"
+	SettingTypeColor                = "color"
+	SettingTypePassword             = "password"
+	SettingTypeTextarea             = "textarea"
+	SettingTypeSelect               = "select"
+	SettingTypeMultiselect          = "multiselect"
+	SettingTypeRadio                = "radio"
+	SettingTypeCheckbox             = "checkbox"
+	SettingTypeImageWidth           = "image_width"
+	SettingTypeMultiSelectCountries = "multi_select_countries"
+	SettingTypeRelativeDateSelector = "relative_date_selector"
+)
+
+type BatchSettingOptionUpdate struct {
+	Update *[]SettingOption `json:"update,omitempty"`
+}
+
+type BatchSettingOptionUpdateResponse struct {
+	Update *[]SettingOption `json:"update,omitempty"`
+}
+
+func (option SettingOption) MarshalJSON() ([]byte, error) {
+	aux := struct {
+		settingOption
+		Value interface{} `json:"value"`
+	}{
+		settingOption: settingOption(option),
+		Value:         encodeSettingValue(option.Value),
+	}
+
+	return json.Marshal(aux)
+}
+
+func (option *SettingOption) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*settingOption
+		Value json.RawMessage `json:"value"`
+	}{
+		settingOption: (*settingOption)(option),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	value, err := decodeSettingValue(option.Type, aux.Value)
+	if err != nil {
+		return err
+	}
+
+	option.Value = value
+
+	return nil
+}
+
+// decodeSettingValue decodes a raw setting value according to its declared type
+func decodeSettingValue(settingType string, raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+
+	switch settingType {
+	case SettingTypeCheckbox:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return v == "yes", nil
+		}
+
+	case SettingTypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case string:
+			if v == "" {
+				return nil, nil
+			}
+
+			// Extensions may store non-numeric values, kept as is
+			if number, err := strconv.ParseFloat(v, 64); err == nil {
+				return number, nil
+			}
+		}
+
+	case SettingTypeMultiselect, SettingTypeMultiSelectCountries:
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				return []string{}, nil
+			}
+
+			return []string{v}, nil
+		case []interface{}, map[string]interface{}:
+			var values []string
+
+			// PHP may encode a non-sequential list as an object
+			if object, ok := v.(map[string]interface{}); ok {
+				keys := make([]string, 0, len(object))
+				for key := range object {
+					keys = append(keys, key)
+				}
+
+				sort.Strings(keys)
+
+				for _, key := range keys {
+					item, ok := object[key].(string)
+					if !ok {
+						return value, nil
+					}
+
+					values = append(values, item)
+				}
+
+				return values, nil
+			}
+
+			// Not a list of strings? (eg. IDs stored by extensions, kept as is)
+			if err := json.Unmarshal(raw, &values); err != nil {
+				return value, nil
+			}
+
+			return values, nil
+		}
+	}
+
+	return value, nil
+}
+
+// encodeSettingValue encodes a setting value the way the API stores it
+func encodeSettingValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "yes"
+		}
+
+		return "no"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	}
+
+	return value
+}
+
+// List all settings groups. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-settings-groups
+func (service *SettingsService) ListGroups() ([]SettingGroup, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/settings", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []SettingGroup
+	response, err := service.client.Do(req, &groups)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return groups, response, nil
+}
+
+// List all setting options of a group. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-setting-options
+func (service *SettingsService) List(groupID string) ([]SettingOption, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/settings/"+groupID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var options []SettingOption
+	response, err := service.client.Do(req, &options)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return options, response, nil
+}
+
+// Get a setting option. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-setting-option
+func (service *SettingsService) Get(groupID string, optionID string) (*SettingOption, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/settings/"+groupID+"/"+optionID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	option := new(SettingOption)
+	response, err := service.client.Do(req, option)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return option, response, nil
+}
+
+// Update a setting option. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-setting-option
+func (service *SettingsService) Update(groupID string, optionID string, option *SettingOption) (*SettingOption, *http.Response, error) {
+	req, err := service.client.NewRequest("PUT", "/settings/"+groupID+"/"+optionID, nil, option)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedOption := new(SettingOption)
+	response, err := service.client.Do(req, updatedOption)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return updatedOption, response, nil
+}
+
+// Batch update setting options. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-setting-options
+func (service *SettingsService) Batch(groupID string, opts *BatchSettingOptionUpdate) (*BatchSettingOptionUpdateResponse, *http.Response, error) {
+	req, err := service.client.NewRequest("POST", "/settings/"+groupID+"/batch", nil, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := new(BatchSettingOptionUpdateResponse)
+	response, err := service.client.Do(req, options)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return options, response, nil
+}
@@ -0,0 +1,147 @@
+package woocommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// SettingsDocument describes desired setting values, keyed by group ID and
+// then by setting option ID. Values follow the SettingOption.Value types.
+type SettingsDocument map[string]map[string]interface{}
+
+// SettingChange describes a setting option whose current value differs from
+// the desired one
+type SettingChange struct {
+	GroupId  string
+	OptionId string
+	Current  interface{}
+	Desired  interface{}
+}
+
+// Diff compares a desired settings document with the store settings and
+// returns the changes needed to reach it, ordered by group and option ID
+func (service *SettingsService) Diff(desired SettingsDocument) ([]SettingChange, error) {
+	var changes []SettingChange
+
+	for _, groupID := range sortedKeys(desired) {
+		options, _, err := service.List(groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		current := make(map[string]SettingOption, len(options))
+		for _, option := range options {
+			current[option.Id] = option
+		}
+
+		for _, optionID := range sortedKeys(desired[groupID]) {
+			option, ok := current[optionID]
+			if !ok {
+				return nil, fmt.Errorf("setting %s/%s does not exist", groupID, optionID)
+			}
+
+			value := desired[groupID][optionID]
+
+			equal, err := settingValuesEqual(option.Value, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if !equal {
+				changes = append(changes, SettingChange{GroupId: groupID, OptionId: optionID, Current: option.Value, Desired: value})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// Apply pushes a desired settings document to the store. Only options whose
+// value differs are updated, with one batch request per changed group, so
+// applying the same document twice is a no-op. It returns the applied changes,
+// along with the errors of the rejected options and of a failed group (the
+// previous groups are applied).
+func (service *SettingsService) Apply(desired SettingsDocument) ([]SettingChange, error) {
+	changes, err := service.Diff(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	groupChanges := map[string][]SettingChange{}
+	var groupIDs []string
+
+	for _, change := range changes {
+		if _, ok := groupChanges[change.GroupId]; !ok {
+			groupIDs = append(groupIDs, change.GroupId)
+		}
+
+		groupChanges[change.GroupId] = append(groupChanges[change.GroupId], change)
+	}
+
+	var applied []SettingChange
+	var failed []error
+
+	for _, groupID := range groupIDs {
+		options := make([]SettingOption, 0, len(groupChanges[groupID]))
+		for _, change := range groupChanges[groupID] {
+			options = append(options, SettingOption{Id: change.OptionId, Value: change.Desired})
+		}
+
+		result, _, err := service.Batch(groupID, &BatchSettingOptionUpdate{Update: &options})
+		if err != nil {
+			return applied, errors.Join(append(failed, fmt.Errorf("settings group %s: %w", groupID, err))...)
+		}
+
+		// Batch requests succeed even when some options are rejected (eg. an
+		// invalid select value), only report the updated ones
+		updated := map[string]bool{}
+
+		if result.Update != nil {
+			for _, option := range *result.Update {
+				if option.Error != nil {
+					failed = append(failed, fmt.Errorf("setting %s/%s: %w", groupID, option.Id, option.Error))
+					continue
+				}
+
+				updated[option.Id] = true
+			}
+		}
+
+		for _, change := range groupChanges[groupID] {
+			if updated[change.OptionId] {
+				applied = append(applied, change)
+			}
+		}
+	}
+
+	return applied, errors.Join(failed...)
+}
+
+// settingValuesEqual compares two setting values as the API would store them
+func settingValuesEqual(a interface{}, b interface{}) (bool, error) {
+	encodedA, err := json.Marshal(encodeSettingValue(a))
+	if err != nil {
+		return false, err
+	}
+
+	encodedB, err := json.Marshal(encodeSettingValue(b))
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(encodedA, encodedB), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
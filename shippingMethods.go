@@ -0,0 +1,48 @@
+package woocommerce
+
+import "net/http"
+
+// Shipping methods service
+type ShippingMethodsService service
+
+// ShippingMethod object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-method-properties
+type ShippingMethod struct {
+	Id          string `json:"id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Links       *Links `json:"_links,omitempty"`
+}
+
+// Get a shipping method. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-method
+func (service *ShippingMethodsService) Get(methodID string) (*ShippingMethod, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/shipping_methods/"+methodID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method := new(ShippingMethod)
+	response, err := service.client.Do(req, method)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return method, response, nil
+}
+
+// List all shipping methods. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-methods
+func (service *ShippingMethodsService) List() ([]ShippingMethod, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/shipping_methods", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var methods []ShippingMethod
+	response, err := service.client.Do(req, &methods)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return methods, response, nil
+}
@@ -0,0 +1,342 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ShippingZoneMethod object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-zone-method-properties
+type ShippingZoneMethod struct {
+	InstanceId        int                                  `json:"instance_id,omitempty"`
+	Title             string                               `json:"title,omitempty"`
+	Order             int                                  `json:"order,omitempty"`
+	Enabled           *bool                                `json:"enabled,omitempty"`
+	MethodId          string                               `json:"method_id,omitempty"`
+	MethodTitle       string                               `json:"method_title,omitempty"`
+	MethodDescription string                               `json:"method_description,omitempty"`
+	Settings          map[string]ShippingZoneMethodSetting `json:"settings,omitempty"`
+	Links             *Links                               `json:"_links,omitempty"`
+}
+
+// ShippingZoneMethodSetting holds a single shipping method instance setting.
+// The API returns the full setting definition but only accepts its value on
+// writes, so a setting marshals to its value alone.
+type ShippingZoneMethodSetting struct {
+	Id          string         `json:"id,omitempty"`
+	Label       string         `json:"label,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Type        string         `json:"type,omitempty"`
+	Value       string         `json:"value,omitempty"`
+	Default     string         `json:"default,omitempty"`
+	Tip         string         `json:"tip,omitempty"`
+	Placeholder string         `json:"placeholder,omitempty"`
+	Options     SettingOptions `json:"options,omitempty"`
+}
+
+type shippingZoneMethodSetting ShippingZoneMethodSetting
+
+type DeleteShippingZoneMethodParams struct {
+	Force bool `url:"force"`
+}
+
+const (
+	ShippingMethodFlatRate     = "flat_rate"
+	ShippingMethodFreeShipping = "free_shipping"
+	ShippingMethodLocalPickup  = "local_pickup"
+)
+
+// ShippingZoneMethodSettings is implemented by the typed settings of the core
+// shipping methods. Values returns the settings that are set (non-empty), so
+// that updates leave the other settings untouched.
+type ShippingZoneMethodSettings interface {
+	MethodID() string
+	Values() map[string]string
+}
+
+// FlatRateSettings are the instance settings of the flat_rate shipping method
+type FlatRateSettings struct {
+	Title       string
+	TaxStatus   string
+	Cost        string
+	NoClassCost string
+	Type        string
+
+	// ClassCosts maps shipping class IDs to their cost
+	ClassCosts map[int]string
+}
+
+// FreeShippingSettings are the instance settings of the free_shipping shipping method
+type FreeShippingSettings struct {
+	Title           string
+	Requires        string
+	MinAmount       Money
+	IgnoreDiscounts *bool
+}
+
+// LocalPickupSettings are the instance settings of the local_pickup shipping method
+type LocalPickupSettings struct {
+	Title     string
+	TaxStatus string
+	Cost      string
+}
+
+const flatRateClassCostPrefix = "class_cost_"
+
+func (setting ShippingZoneMethodSetting) MarshalJSON() ([]byte, error) {
+	return json.Marshal(setting.Value)
+}
+
+func (setting *ShippingZoneMethodSetting) UnmarshalJSON(data []byte) error {
+	// Plain value? (eg. a setting echoed back from a write)
+	if len(data) > 0 && data[0] != '{' {
+		return json.Unmarshal(data, &setting.Value)
+	}
+
+	return json.Unmarshal(data, (*shippingZoneMethodSetting)(setting))
+}
+
+// NewShippingZoneMethod builds an enabled zone method from typed settings
+func NewShippingZoneMethod(settings ShippingZoneMethodSettings) *ShippingZoneMethod {
+	method := &ShippingZoneMethod{MethodId: settings.MethodID(), Enabled: Bool(true)}
+	method.SetSettings(settings)
+
+	return method
+}
+
+// SetSettings writes typed settings into the method, keeping any other setting untouched
+func (method *ShippingZoneMethod) SetSettings(settings ShippingZoneMethodSettings) {
+	if method.Settings == nil {
+		method.Settings = map[string]ShippingZoneMethodSetting{}
+	}
+
+	for id, value := range settings.Values() {
+		setting := method.Settings[id]
+		setting.Id = id
+		setting.Value = value
+
+		method.Settings[id] = setting
+	}
+}
+
+// FlatRateSettings returns the typed settings of a flat_rate method, or nil for other methods
+func (method *ShippingZoneMethod) FlatRateSettings() *FlatRateSettings {
+	if method.MethodId != ShippingMethodFlatRate {
+		return nil
+	}
+
+	settings := &FlatRateSettings{
+		Title:       method.settingValue("title"),
+		TaxStatus:   method.settingValue("tax_status"),
+		Cost:        method.settingValue("cost"),
+		NoClassCost: method.settingValue("no_class_cost"),
+		Type:        method.settingValue("type"),
+		ClassCosts:  map[int]string{},
+	}
+
+	for id, setting := range method.Settings {
+		if !strings.HasPrefix(id, flatRateClassCostPrefix) {
+			continue
+		}
+
+		classID, err := strconv.Atoi(strings.TrimPrefix(id, flatRateClassCostPrefix))
+		if err != nil {
+			continue
+		}
+
+		settings.ClassCosts[classID] = setting.Value
+	}
+
+	return settings
+}
+
+// FreeShippingSettings returns the typed settings of a free_shipping method, or nil for other methods
+func (method *ShippingZoneMethod) FreeShippingSettings() *FreeShippingSettings {
+	if method.MethodId != ShippingMethodFreeShipping {
+		return nil
+	}
+
+	settings := &FreeShippingSettings{
+		Title:     method.settingValue("title"),
+		Requires:  method.settingValue("requires"),
+		MinAmount: Money(method.settingValue("min_amount")),
+	}
+
+	if ignoreDiscounts := method.settingValue("ignore_discounts"); ignoreDiscounts != "" {
+		settings.IgnoreDiscounts = Bool(ignoreDiscounts == "yes")
+	}
+
+	return settings
+}
+
+// LocalPickupSettings returns the typed settings of a local_pickup method, or nil for other methods
+func (method *ShippingZoneMethod) LocalPickupSettings() *LocalPickupSettings {
+	if method.MethodId != ShippingMethodLocalPickup {
+		return nil
+	}
+
+	return &LocalPickupSettings{
+		Title:     method.settingValue("title"),
+		TaxStatus: method.settingValue("tax_status"),
+		Cost:      method.settingValue("cost"),
+	}
+}
+
+func (method *ShippingZoneMethod) settingValue(id string) string {
+	return method.Settings[id].Value
+}
+
+func (settings *FlatRateSettings) MethodID() string {
+	return ShippingMethodFlatRate
+}
+
+func (settings *FlatRateSettings) Values() map[string]string {
+	values := setSettingValues(map[string]string{
+		"title":         settings.Title,
+		"tax_status":    settings.TaxStatus,
+		"cost":          settings.Cost,
+		"no_class_cost": settings.NoClassCost,
+		"type":          settings.Type,
+	})
+
+	for classID, cost := range settings.ClassCosts {
+		values[flatRateClassCostPrefix+strconv.Itoa(classID)] = cost
+	}
+
+	return values
+}
+
+func (settings *FreeShippingSettings) MethodID() string {
+	return ShippingMethodFreeShipping
+}
+
+func (settings *FreeShippingSettings) Values() map[string]string {
+	values := setSettingValues(map[string]string{
+		"title":      settings.Title,
+		"requires":   settings.Requires,
+		"min_amount": string(settings.MinAmount),
+	})
+
+	if settings.IgnoreDiscounts != nil {
+		values["ignore_discounts"] = "no"
+		if *settings.IgnoreDiscounts {
+			values["ignore_discounts"] = "yes"
+		}
+	}
+
+	return values
+}
+
+func (settings *LocalPickupSettings) MethodID() string {
+	return ShippingMethodLocalPickup
+}
+
+func (settings *LocalPickupSettings) Values() map[string]string {
+	return setSettingValues(map[string]string{
+		"title":      settings.Title,
+		"tax_status": settings.TaxStatus,
+		"cost":       settings.Cost,
+	})
+}
+
+// setSettingValues removes the empty values of unset settings
+func setSettingValues(values map[string]string) map[string]string {
+	for id, value := range values {
+		if value == "" {
+			delete(values, id)
+		}
+	}
+
+	return values
+}
+
+// Create a shipping zone method. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#include-a-shipping-method-to-a-shipping-zone
+func (service *ShippingZonesService) CreateMethod(zoneID int, method *ShippingZoneMethod) (*ShippingZoneMethod, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/methods"
+	req, err := service.client.NewRequest("POST", _url, nil, method)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdMethod := new(ShippingZoneMethod)
+	response, err := service.client.Do(req, createdMethod)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return createdMethod, response, nil
+}
+
+// Get a shipping zone method. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-zone-method
+func (service *ShippingZonesService) GetMethod(zoneID int, instanceID int) (*ShippingZoneMethod, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/methods/" + strconv.Itoa(instanceID)
+	req, err := service.client.NewRequest("GET", _url, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method := new(ShippingZoneMethod)
+	response, err := service.client.Do(req, method)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return method, response, nil
+}
+
+// List all shipping zone methods. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-zone-methods
+func (service *ShippingZonesService) ListMethods(zoneID int) ([]ShippingZoneMethod, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/methods"
+	req, err := service.client.NewRequest("GET", _url, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var methods []ShippingZoneMethod
+	response, err := service.client.Do(req, &methods)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return methods, response, nil
+}
+
+// Update a shipping zone method. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-zone-method
+func (service *ShippingZonesService) UpdateMethod(zoneID int, instanceID int, method *ShippingZoneMethod) (*ShippingZoneMethod, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/methods/" + strconv.Itoa(instanceID)
+	req, err := service.client.NewRequest("PUT", _url, nil, method)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedMethod := new(ShippingZoneMethod)
+	response, err := service.client.Do(req, updatedMethod)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return updatedMethod, response, nil
+}
+
+// Delete a shipping zone method. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-zone-method
+func (service *ShippingZonesService) DeleteMethod(zoneID int, instanceID int, opts *DeleteShippingZoneMethodParams) (*ShippingZoneMethod, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/methods/" + strconv.Itoa(instanceID)
+	req, err := service.client.NewRequest("DELETE", _url, opts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method := new(ShippingZoneMethod)
+	response, err := service.client.Do(req, method)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return method, response, nil
+}
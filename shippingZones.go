@@ -0,0 +1,163 @@
+package woocommerce
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Shipping zones service
+type ShippingZonesService service
+
+// ShippingZone object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-zone-properties
+type ShippingZone struct {
+	Id    int    `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Order int    `json:"order,omitempty"`
+	Links *Links `json:"_links,omitempty"`
+}
+
+// ShippingZoneLocation object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-location-properties
+type ShippingZoneLocation struct {
+	Code  string `json:"code,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Links *Links `json:"_links,omitempty"`
+}
+
+const (
+	ShippingZoneLocationPostcode  = "postcode"
+	ShippingZoneLocationState     = "state"
+	ShippingZoneLocationCountry   = "country"
+	ShippingZoneLocationContinent = "continent"
+)
+
+type DeleteShippingZoneParams struct {
+	Force bool `url:"force"`
+}
+
+// Create a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-shipping-zone
+func (service *ShippingZonesService) Create(zone *ShippingZone) (*ShippingZone, *http.Response, error) {
+	req, err := service.client.NewRequest("POST", "/shipping/zones", nil, zone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdZone := new(ShippingZone)
+	response, err := service.client.Do(req, createdZone)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return createdZone, response, nil
+}
+
+// Get a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-zone
+func (service *ShippingZonesService) Get(zoneID int) (*ShippingZone, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/shipping/zones/"+strconv.Itoa(zoneID), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone := new(ShippingZone)
+	response, err := service.client.Do(req, zone)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return zone, response, nil
+}
+
+// List all shipping zones. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-zones
+func (service *ShippingZonesService) List() ([]ShippingZone, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/shipping/zones", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var zones []ShippingZone
+	response, err := service.client.Do(req, &zones)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return zones, response, nil
+}
+
+// Update a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-zone
+func (service *ShippingZonesService) Update(zoneID int, zone *ShippingZone) (*ShippingZone, *http.Response, error) {
+	req, err := service.client.NewRequest("PUT", "/shipping/zones/"+strconv.Itoa(zoneID), nil, zone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedZone := new(ShippingZone)
+	response, err := service.client.Do(req, updatedZone)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return updatedZone, response, nil
+}
+
+// Delete a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-zone
+func (service *ShippingZonesService) Delete(zoneID int, opts *DeleteShippingZoneParams) (*ShippingZone, *http.Response, error) {
+	req, err := service.client.NewRequest("DELETE", "/shipping/zones/"+strconv.Itoa(zoneID), opts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone := new(ShippingZone)
+	response, err := service.client.Do(req, zone)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return zone, response, nil
+}
+
+// Get the locations of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-zone-locations
+func (service *ShippingZonesService) GetLocations(zoneID int) ([]ShippingZoneLocation, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/locations"
+	req, err := service.client.NewRequest("GET", _url, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locations []ShippingZoneLocation
+	response, err := service.client.Do(req, &locations)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return locations, response, nil
+}
+
+// Update the locations of a shipping zone. The given locations replace all
+// existing ones. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-locations-of-a-shipping-zone
+func (service *ShippingZonesService) UpdateLocations(zoneID int, locations []ShippingZoneLocation) ([]ShippingZoneLocation, *http.Response, error) {
+	_url := "/shipping/zones/" + strconv.Itoa(zoneID) + "/locations"
+
+	// Always send a JSON array, an empty list clears the zone locations
+	if locations == nil {
+		locations = []ShippingZoneLocation{}
+	}
+
+	req, err := service.client.NewRequest("PUT", _url, nil, locations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedLocations []ShippingZoneLocation
+	response, err := service.client.Do(req, &updatedLocations)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return updatedLocations, response, nil
+}
@@ -0,0 +1,307 @@
+package woocommerce
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// Store API cart service
+type StoreCartService storeService
+
+// StoreCart object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+type StoreCart struct {
+	Items                 []StoreCartItem        `json:"items,omitempty"`
+	Coupons               []StoreCartCoupon      `json:"coupons,omitempty"`
+	Fees                  []StoreCartFee         `json:"fees,omitempty"`
+	Totals                *StoreCartTotals       `json:"totals,omitempty"`
+	ShippingAddress       *StoreAddress          `json:"shipping_address,omitempty"`
+	BillingAddress        *StoreAddress          `json:"billing_address,omitempty"`
+	NeedsPayment          bool                   `json:"needs_payment"`
+	NeedsShipping         bool                   `json:"needs_shipping"`
+	PaymentRequirements   []string               `json:"payment_requirements,omitempty"`
+	HasCalculatedShipping bool                   `json:"has_calculated_shipping"`
+	ShippingRates         []StoreShippingPackage `json:"shipping_rates,omitempty"`
+	ItemsCount            int                    `json:"items_count"`
+	ItemsWeight           float64                `json:"items_weight"`
+	CrossSells            []StoreProduct         `json:"cross_sells,omitempty"`
+	Errors                []StoreError           `json:"errors,omitempty"`
+	PaymentMethods        []string               `json:"payment_methods,omitempty"`
+	Extensions            map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type StoreCartItem struct {
+	Key                string               `json:"key,omitempty"`
+	Id                 int                  `json:"id,omitempty"`
+	Type               string               `json:"type,omitempty"`
+	Quantity           int                  `json:"quantity,omitempty"`
+	QuantityLimits     *StoreQuantityLimits `json:"quantity_limits,omitempty"`
+	Name               string               `json:"name,omitempty"`
+	ShortDescription   string               `json:"short_description,omitempty"`
+	Description        string               `json:"description,omitempty"`
+	Sku                string               `json:"sku,omitempty"`
+	LowStockRemaining  interface{}          `json:"low_stock_remaining,omitempty"`
+	BackordersAllowed  bool                 `json:"backorders_allowed"`
+	ShowBackorderBadge bool                 `json:"show_backorder_badge"`
+	SoldIndividually   bool                 `json:"sold_individually"`
+	Permalink          string               `json:"permalink,omitempty"`
+	Images             []StoreImage         `json:"images,omitempty"`
+	Variation          []StoreItemAttribute `json:"variation,omitempty"`
+	ItemData           []interface{}        `json:"item_data,omitempty"`
+	Prices             *StorePrices         `json:"prices,omitempty"`
+	Totals             *StoreCartItemTotals `json:"totals,omitempty"`
+	CatalogVisibility  string               `json:"catalog_visibility,omitempty"`
+}
+
+type StoreQuantityLimits struct {
+	Minimum    int  `json:"minimum"`
+	Maximum    int  `json:"maximum"`
+	MultipleOf int  `json:"multiple_of"`
+	Editable   bool `json:"editable"`
+}
+
+type StoreCartItemTotals struct {
+	StoreCurrency
+	LineSubtotal    string `json:"line_subtotal,omitempty"`
+	LineSubtotalTax string `json:"line_subtotal_tax,omitempty"`
+	LineTotal       string `json:"line_total,omitempty"`
+	LineTotalTax    string `json:"line_total_tax,omitempty"`
+}
+
+type StoreCartCoupon struct {
+	Code         string `json:"code,omitempty"`
+	DiscountType string `json:"discount_type,omitempty"`
+	Totals       *struct {
+		StoreCurrency
+		TotalDiscount    string `json:"total_discount,omitempty"`
+		TotalDiscountTax string `json:"total_discount_tax,omitempty"`
+	} `json:"totals,omitempty"`
+}
+
+type StoreCartFee struct {
+	Key    string `json:"key,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Totals *struct {
+		StoreCurrency
+		Total    string `json:"total,omitempty"`
+		TotalTax string `json:"total_tax,omitempty"`
+	} `json:"totals,omitempty"`
+}
+
+type StoreCartTotals struct {
+	StoreCurrency
+	TotalItems       string         `json:"total_items,omitempty"`
+	TotalItemsTax    string         `json:"total_items_tax,omitempty"`
+	TotalFees        string         `json:"total_fees,omitempty"`
+	TotalFeesTax     string         `json:"total_fees_tax,omitempty"`
+	TotalDiscount    string         `json:"total_discount,omitempty"`
+	TotalDiscountTax string         `json:"total_discount_tax,omitempty"`
+	TotalShipping    string         `json:"total_shipping,omitempty"`
+	TotalShippingTax string         `json:"total_shipping_tax,omitempty"`
+	TotalPrice       string         `json:"total_price,omitempty"`
+	TotalTax         string         `json:"total_tax,omitempty"`
+	TaxLines         []StoreTaxLine `json:"tax_lines,omitempty"`
+}
+
+type StoreTaxLine struct {
+	Name  string `json:"name,omitempty"`
+	Price string `json:"price,omitempty"`
+	Rate  string `json:"rate,omitempty"`
+}
+
+type StoreAddress struct {
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Address1  string `json:"address_1,omitempty"`
+	Address2  string `json:"address_2,omitempty"`
+	City      string `json:"city,omitempty"`
+	State     string `json:"state,omitempty"`
+	Postcode  string `json:"postcode,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+}
+
+// StoreShippingPackage holds the shipping rates available for a cart package
+type StoreShippingPackage struct {
+	PackageId     interface{}         `json:"package_id,omitempty"`
+	Name          string              `json:"name,omitempty"`
+	Destination   *StoreAddress       `json:"destination,omitempty"`
+	Items         []StorePackageItem  `json:"items,omitempty"`
+	ShippingRates []StoreShippingRate `json:"shipping_rates,omitempty"`
+}
+
+type StorePackageItem struct {
+	Key      string `json:"key,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+}
+
+type StoreShippingRate struct {
+	StoreCurrency
+	RateId       string     `json:"rate_id,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	DeliveryTime string     `json:"delivery_time,omitempty"`
+	Price        string     `json:"price,omitempty"`
+	Taxes        string     `json:"taxes,omitempty"`
+	InstanceId   int        `json:"instance_id,omitempty"`
+	MethodId     string     `json:"method_id,omitempty"`
+	MetaData     []MetaData `json:"meta_data,omitempty"`
+	Selected     bool       `json:"selected"`
+}
+
+type StoreError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// StoreCartItemRequest adds or updates a cart item
+type StoreCartItemRequest struct {
+	Key       string               `json:"key,omitempty"`
+	Id        int                  `json:"id,omitempty"`
+	Quantity  int                  `json:"quantity,omitempty"`
+	Variation []StoreItemAttribute `json:"variation,omitempty"`
+}
+
+// StoreCustomerRequest updates the cart customer addresses
+type StoreCustomerRequest struct {
+	BillingAddress  *StoreAddress `json:"billing_address,omitempty"`
+	ShippingAddress *StoreAddress `json:"shipping_address,omitempty"`
+}
+
+type storeSelectShippingRateRequest struct {
+	PackageId interface{} `json:"package_id"`
+	RateId    string      `json:"rate_id"`
+}
+
+type storeCouponRequest struct {
+	Code string `json:"code"`
+}
+
+// Get the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#get-cart
+func (service *StoreCartService) Get() (*StoreCart, *http.Response, error) {
+	return service.cartRequest("GET", "/cart", nil)
+}
+
+// Add an item to the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#add-item
+func (service *StoreCartService) AddItem(item *StoreCartItemRequest) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/add-item", item)
+}
+
+// Update the quantity of a cart item. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#update-item
+func (service *StoreCartService) UpdateItem(key string, quantity int) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/update-item", &StoreCartItemRequest{Key: key, Quantity: quantity})
+}
+
+// Remove an item from the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#remove-item
+func (service *StoreCartService) RemoveItem(key string) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/remove-item", &StoreCartItemRequest{Key: key})
+}
+
+// Apply a coupon to the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#apply-coupon
+func (service *StoreCartService) ApplyCoupon(code string) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/apply-coupon", &storeCouponRequest{Code: code})
+}
+
+// Remove a coupon from the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#remove-coupon
+func (service *StoreCartService) RemoveCoupon(code string) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/remove-coupon", &storeCouponRequest{Code: code})
+}
+
+// Update the cart customer addresses. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#update-customer
+func (service *StoreCartService) UpdateCustomer(customer *StoreCustomerRequest) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/update-customer", customer)
+}
+
+// Select a shipping rate for a cart package. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md#select-shipping-rate
+func (service *StoreCartService) SelectShippingRate(packageID interface{}, rateID string) (*StoreCart, *http.Response, error) {
+	return service.cartRequest("POST", "/cart/select-shipping-rate", &storeSelectShippingRateRequest{PackageId: packageID, RateId: rateID})
+}
+
+// List cart items. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md#list-cart-items
+func (service *StoreCartService) ListItems() ([]StoreCartItem, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/cart/items", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []StoreCartItem
+	response, err := service.client.Do(req, &items)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return items, response, nil
+}
+
+// Get a cart item. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md#single-cart-item
+func (service *StoreCartService) GetItem(key string) (*StoreCartItem, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/cart/items/"+url.PathEscape(key), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(StoreCartItem)
+	response, err := service.client.Do(req, item)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return item, response, nil
+}
+
+// Delete all cart items. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md#delete-all-cart-items
+func (service *StoreCartService) DeleteItems() (*http.Response, error) {
+	req, err := service.client.NewRequest("DELETE", "/cart/items", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.client.Do(req, nil)
+}
+
+// List the coupons applied to the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md#list-cart-coupons
+func (service *StoreCartService) ListCoupons() ([]StoreCartCoupon, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/cart/coupons", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var coupons []StoreCartCoupon
+	response, err := service.client.Do(req, &coupons)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return coupons, response, nil
+}
+
+// ShippingRates returns the shipping rates of the cart packages
+func (service *StoreCartService) ShippingRates() ([]StoreShippingPackage, *http.Response, error) {
+	cart, response, err := service.Get()
+	if err != nil {
+		return nil, response, err
+	}
+
+	return cart.ShippingRates, response, nil
+}
+
+func (service *StoreCartService) cartRequest(method string, urlStr string, body interface{}) (*StoreCart, *http.Response, error) {
+	req, err := service.client.NewRequest(method, urlStr, nil, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cart := new(StoreCart)
+	response, err := service.client.Do(req, cart)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return cart, response, nil
+}
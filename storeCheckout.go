@@ -0,0 +1,76 @@
+package woocommerce
+
+import "net/http"
+
+// Store API checkout service
+type StoreCheckoutService storeService
+
+// StoreCheckout object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+type StoreCheckout struct {
+	OrderId         int                    `json:"order_id,omitempty"`
+	Status          string                 `json:"status,omitempty"`
+	OrderKey        string                 `json:"order_key,omitempty"`
+	CustomerNote    string                 `json:"customer_note,omitempty"`
+	CustomerId      int                    `json:"customer_id,omitempty"`
+	BillingAddress  *StoreAddress          `json:"billing_address,omitempty"`
+	ShippingAddress *StoreAddress          `json:"shipping_address,omitempty"`
+	PaymentMethod   string                 `json:"payment_method,omitempty"`
+	PaymentResult   *StorePaymentResult    `json:"payment_result,omitempty"`
+	Extensions      map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type StorePaymentResult struct {
+	PaymentStatus  string             `json:"payment_status,omitempty"`
+	PaymentDetails []StorePaymentData `json:"payment_details,omitempty"`
+	RedirectUrl    string             `json:"redirect_url,omitempty"`
+}
+
+type StorePaymentData struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StoreCheckoutRequest places an order from the cart
+type StoreCheckoutRequest struct {
+	BillingAddress  *StoreAddress          `json:"billing_address,omitempty"`
+	ShippingAddress *StoreAddress          `json:"shipping_address,omitempty"`
+	CustomerNote    string                 `json:"customer_note,omitempty"`
+	CreateAccount   bool                   `json:"create_account,omitempty"`
+	PaymentMethod   string                 `json:"payment_method,omitempty"`
+	PaymentData     []StorePaymentData     `json:"payment_data,omitempty"`
+	Extensions      map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Get the checkout draft order. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md#get-checkout-data
+func (service *StoreCheckoutService) Get() (*StoreCheckout, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/checkout", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checkout := new(StoreCheckout)
+	response, err := service.client.Do(req, checkout)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return checkout, response, nil
+}
+
+// Process the checkout and place the order. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md#process-order-and-payment
+func (service *StoreCheckoutService) Process(checkout *StoreCheckoutRequest) (*StoreCheckout, *http.Response, error) {
+	req, err := service.client.NewRequest("POST", "/checkout", nil, checkout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	processedCheckout := new(StoreCheckout)
+	response, err := service.client.Do(req, processedCheckout)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return processedCheckout, response, nil
+}
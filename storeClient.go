@@ -0,0 +1,118 @@
+package woocommerce
+
+import (
+	"net/http"
+	"sync"
+)
+
+const (
+	storeNamespace       = "wc/store/v1"
+	storeNonceHeader     = "Nonce"
+	storeCartTokenHeader = "Cart-Token"
+)
+
+// StoreClient is a client for the public Store API (wc/store), used by
+// storefronts for cart and checkout flows. It is not authenticated with API
+// keys: it keeps the cart session (nonce and cart token) returned by the store
+// and sends it back on every request.
+type StoreClient struct {
+	client *Client
+
+	mu        sync.Mutex
+	nonce     string
+	cartToken string
+
+	Products *StoreProductsService
+	Cart     *StoreCartService
+	Checkout *StoreCheckoutService
+}
+
+type storeService struct {
+	client *StoreClient
+}
+
+func NewStoreClient(shopURL string) (*StoreClient, error) {
+	client, err := New(shopURL)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &StoreClient{client: client}
+
+	// Map services
+	store.Products = &StoreProductsService{client: store}
+	store.Cart = &StoreCartService{client: store}
+	store.Checkout = &StoreCheckoutService{client: store}
+
+	return store, nil
+}
+
+// Nonce returns the current session nonce
+func (store *StoreClient) Nonce() string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.nonce
+}
+
+// CartToken returns the current cart token, which can be saved to resume the cart session later
+func (store *StoreClient) CartToken() string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.cartToken
+}
+
+// SetSession restores a cart session, eg. from a previously saved cart token
+func (store *StoreClient) SetSession(nonce string, cartToken string) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.nonce = nonce
+	store.cartToken = cartToken
+}
+
+// NewRequest creates a Store API request carrying the cart session headers
+func (store *StoreClient) NewRequest(method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
+	req, err := store.client.NewNamespaceRequest(method, storeNamespace, urlStr, opts, body)
+	if err != nil {
+		return nil, err
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if store.nonce != "" {
+		req.Header.Set(storeNonceHeader, store.nonce)
+	}
+
+	if store.cartToken != "" {
+		req.Header.Set(storeCartTokenHeader, store.cartToken)
+	}
+
+	return req, nil
+}
+
+// Do sends a Store API request and saves the cart session headers of the response
+func (store *StoreClient) Do(req *http.Request, v interface{}) (*http.Response, error) {
+	response, err := store.client.Do(req, v)
+
+	if response != nil {
+		store.saveSession(response)
+	}
+
+	return response, err
+}
+
+func (store *StoreClient) saveSession(response *http.Response) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if nonce := response.Header.Get(storeNonceHeader); nonce != "" {
+		store.nonce = nonce
+	}
+
+	if cartToken := response.Header.Get(storeCartTokenHeader); cartToken != "" {
+		store.cartToken = cartToken
+	}
+}
@@ -0,0 +1,185 @@
+package woocommerce
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Store API products service
+type StoreProductsService storeService
+
+// StoreProduct object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/products.md
+type StoreProduct struct {
+	Id                int                     `json:"id,omitempty"`
+	Name              string                  `json:"name,omitempty"`
+	Slug              string                  `json:"slug,omitempty"`
+	Parent            int                     `json:"parent,omitempty"`
+	Type              string                  `json:"type,omitempty"`
+	Variation         string                  `json:"variation,omitempty"`
+	Permalink         string                  `json:"permalink,omitempty"`
+	Sku               string                  `json:"sku,omitempty"`
+	ShortDescription  string                  `json:"short_description,omitempty"`
+	Description       string                  `json:"description,omitempty"`
+	OnSale            bool                    `json:"on_sale"`
+	Prices            *StorePrices            `json:"prices,omitempty"`
+	PriceHtml         string                  `json:"price_html,omitempty"`
+	AverageRating     string                  `json:"average_rating,omitempty"`
+	ReviewCount       int                     `json:"review_count,omitempty"`
+	Images            []StoreImage            `json:"images,omitempty"`
+	Categories        []StoreTerm             `json:"categories,omitempty"`
+	Tags              []StoreTerm             `json:"tags,omitempty"`
+	Attributes        []StoreProductAttribute `json:"attributes,omitempty"`
+	Variations        []StoreProductVariation `json:"variations,omitempty"`
+	HasOptions        bool                    `json:"has_options"`
+	IsPurchasable     bool                    `json:"is_purchasable"`
+	IsInStock         bool                    `json:"is_in_stock"`
+	IsOnBackorder     bool                    `json:"is_on_backorder"`
+	LowStockRemaining interface{}             `json:"low_stock_remaining,omitempty"`
+	SoldIndividually  bool                    `json:"sold_individually"`
+	AddToCart         *StoreAddToCart         `json:"add_to_cart,omitempty"`
+}
+
+// StoreCurrency holds the currency information attached to Store API prices.
+// Store API prices are strings in the currency minor unit (eg. cents).
+type StoreCurrency struct {
+	CurrencyCode              string `json:"currency_code,omitempty"`
+	CurrencySymbol            string `json:"currency_symbol,omitempty"`
+	CurrencyMinorUnit         int    `json:"currency_minor_unit"`
+	CurrencyDecimalSeparator  string `json:"currency_decimal_separator,omitempty"`
+	CurrencyThousandSeparator string `json:"currency_thousand_separator,omitempty"`
+	CurrencyPrefix            string `json:"currency_prefix,omitempty"`
+	CurrencySuffix            string `json:"currency_suffix,omitempty"`
+}
+
+// Amount converts a Store API price in the currency minor unit (eg. "1999")
+// to a Money amount (eg. "19.99")
+func (currency StoreCurrency) Amount(price string) (Money, error) {
+	if price == "" {
+		return "", nil
+	}
+
+	units, err := ParseMoney(price)
+	if err != nil {
+		return "", err
+	}
+
+	coefficient, scale := units.decimal()
+
+	return formatDecimal(coefficient, scale+currency.CurrencyMinorUnit), nil
+}
+
+type StorePrices struct {
+	StoreCurrency
+	Price        string `json:"price,omitempty"`
+	RegularPrice string `json:"regular_price,omitempty"`
+	SalePrice    string `json:"sale_price,omitempty"`
+	PriceRange   *struct {
+		MinAmount string `json:"min_amount,omitempty"`
+		MaxAmount string `json:"max_amount,omitempty"`
+	} `json:"price_range,omitempty"`
+}
+
+type StoreImage struct {
+	Id        int    `json:"id,omitempty"`
+	Src       string `json:"src,omitempty"`
+	Thumbnail string `json:"thumbnail,omitempty"`
+	Srcset    string `json:"srcset,omitempty"`
+	Sizes     string `json:"sizes,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Alt       string `json:"alt,omitempty"`
+}
+
+type StoreTerm struct {
+	Id   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+	Link string `json:"link,omitempty"`
+}
+
+type StoreProductAttribute struct {
+	Id            int         `json:"id,omitempty"`
+	Name          string      `json:"name,omitempty"`
+	Taxonomy      string      `json:"taxonomy,omitempty"`
+	HasVariations bool        `json:"has_variations"`
+	Terms         []StoreTerm `json:"terms,omitempty"`
+}
+
+type StoreProductVariation struct {
+	Id         int                  `json:"id,omitempty"`
+	Attributes []StoreItemAttribute `json:"attributes,omitempty"`
+}
+
+// StoreItemAttribute is an attribute name and value pair of a product variation
+type StoreItemAttribute struct {
+	Name      string `json:"name,omitempty"`
+	Attribute string `json:"attribute,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type StoreAddToCart struct {
+	Text        string `json:"text,omitempty"`
+	Description string `json:"description,omitempty"`
+	Url         string `json:"url,omitempty"`
+	Minimum     int    `json:"minimum,omitempty"`
+	Maximum     int    `json:"maximum,omitempty"`
+	MultipleOf  int    `json:"multiple_of,omitempty"`
+}
+
+type ListStoreProductsParams struct {
+	Page              int       `url:"page,omitempty"`
+	PerPage           int       `url:"per_page,omitempty"`
+	Search            string    `url:"search,omitempty"`
+	After             string `url:"after,omitempty"`
+	Before            string `url:"before,omitempty"`
+	DateColumn        string    `url:"date_column,omitempty"`
+	Exclude           *[]int    `url:"exclude,omitempty"`
+	Include           *[]int    `url:"include,omitempty"`
+	Offset            int       `url:"offset,omitempty"`
+	Order             string    `url:"order,omitempty"`
+	OrderBy           string    `url:"orderby,omitempty"`
+	Parent            *[]int    `url:"parent,omitempty"`
+	Type              string    `url:"type,omitempty"`
+	Sku               string    `url:"sku,omitempty"`
+	Featured          bool      `url:"featured,omitempty"`
+	Category          string    `url:"category,omitempty"`
+	Tag               string    `url:"tag,omitempty"`
+	OnSale            bool      `url:"on_sale,omitempty"`
+	MinPrice          string    `url:"min_price,omitempty"`
+	MaxPrice          string    `url:"max_price,omitempty"`
+	StockStatus       string    `url:"stock_status,omitempty"`
+	CatalogVisibility string    `url:"catalog_visibility,omitempty"`
+}
+
+// Get a product. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/products.md#single-product-by-id
+func (service *StoreProductsService) Get(productID int) (*StoreProduct, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/products/"+strconv.Itoa(productID), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	product := new(StoreProduct)
+	response, err := service.client.Do(req, product)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return product, response, nil
+}
+
+// List products. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/products.md#list-products
+func (service *StoreProductsService) List(opts *ListStoreProductsParams) ([]StoreProduct, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/products", opts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []StoreProduct
+	response, err := service.client.Do(req, &products)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return products, response, nil
+}
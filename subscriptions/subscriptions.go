@@ -0,0 +1,156 @@
+// Package subscriptions is a client for the WooCommerce Subscriptions
+// extension REST API, built on the core woocommerce Client.
+package subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+const (
+	NamespaceV1 = "wc/v1"
+	NamespaceV3 = "wc/v3"
+)
+
+const (
+	StatusPending       = "pending"
+	StatusActive        = "active"
+	StatusOnHold        = "on-hold"
+	StatusCancelled     = "cancelled"
+	StatusSwitched      = "switched"
+	StatusExpired       = "expired"
+	StatusPendingCancel = "pending-cancel"
+)
+
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+	PeriodYear  = "year"
+)
+
+// Subscriptions service
+type Service struct {
+	client    *woocommerce.Client
+	namespace string
+}
+
+// Subscription object, an order with its billing schedule. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#subscription-properties
+type Subscription struct {
+	woocommerce.Order
+
+	BillingPeriod            string              `json:"billing_period,omitempty"`
+	BillingInterval          json.Number         `json:"billing_interval,omitempty"`
+	StartDate                *woocommerce.WCTime `json:"start_date,omitempty"`
+	StartDateGmt             *woocommerce.WCTime `json:"start_date_gmt,omitempty"`
+	TrialEndDate             *woocommerce.WCTime `json:"trial_end_date,omitempty"`
+	TrialEndDateGmt          *woocommerce.WCTime `json:"trial_end_date_gmt,omitempty"`
+	NextPaymentDate          *woocommerce.WCTime `json:"next_payment_date,omitempty"`
+	NextPaymentDateGmt       *woocommerce.WCTime `json:"next_payment_date_gmt,omitempty"`
+	LastPaymentDate          *woocommerce.WCTime `json:"last_payment_date,omitempty"`
+	LastPaymentDateGmt       *woocommerce.WCTime `json:"last_payment_date_gmt,omitempty"`
+	PaymentRetryDate         *woocommerce.WCTime `json:"payment_retry_date,omitempty"`
+	PaymentRetryDateGmt      *woocommerce.WCTime `json:"payment_retry_date_gmt,omitempty"`
+	CancelledDate            *woocommerce.WCTime `json:"cancelled_date,omitempty"`
+	CancelledDateGmt         *woocommerce.WCTime `json:"cancelled_date_gmt,omitempty"`
+	EndDate                  *woocommerce.WCTime `json:"end_date,omitempty"`
+	EndDateGmt               *woocommerce.WCTime `json:"end_date_gmt,omitempty"`
+	ResubscribedFrom         string              `json:"resubscribed_from,omitempty"`
+	ResubscribedSubscription string              `json:"resubscribed_subscription,omitempty"`
+}
+
+type ListParams struct {
+	Context        string    `url:"context,omitempty"`
+	Page           int       `url:"page,omitempty"`
+	PerPage        int       `url:"per_page,omitempty"`
+	Search         string    `url:"search,omitempty"`
+	After          string `url:"after,omitempty"`
+	Before         string `url:"before,omitempty"`
+	ModifiedAfter  string `url:"modified_after,omitempty"`
+	ModifiedBefore string `url:"modified_before,omitempty"`
+	Exclude        *[]int    `url:"exclude,omitempty"`
+	Include        *[]int    `url:"include,omitempty"`
+	Offset         int       `url:"offset,omitempty"`
+	Order          string    `url:"order,omitempty"`
+	OrderBy        string    `url:"orderby,omitempty"`
+	Parent         *[]int    `url:"parent,omitempty"`
+	Status         string    `url:"status,omitempty"`
+	Customer       int       `url:"customer,omitempty"`
+	Product        int       `url:"product,omitempty"`
+}
+
+type DeleteParams struct {
+	Force bool `url:"force"`
+}
+
+// New creates a subscriptions service using the wc/v3 namespace
+func New(client *woocommerce.Client) *Service {
+	return &Service{client: client, namespace: NamespaceV3}
+}
+
+// NewV1 creates a subscriptions service using the legacy wc/v1 namespace
+func NewV1(client *woocommerce.Client) *Service {
+	return &Service{client: client, namespace: NamespaceV1}
+}
+
+// Create a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#create-a-subscription
+func (service *Service) Create(ctx context.Context, subscription *Subscription) (*Subscription, *http.Response, error) {
+	return woocommerce.NamespaceCall[Subscription](ctx, service.client, service.namespace, "POST", "/subscriptions", nil, subscription)
+}
+
+// Get a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#retrieve-a-subscription
+func (service *Service) Get(ctx context.Context, subscriptionID int) (*Subscription, *http.Response, error) {
+	return woocommerce.NamespaceCall[Subscription](ctx, service.client, service.namespace, "GET", "/subscriptions/"+strconv.Itoa(subscriptionID), nil, nil)
+}
+
+// List subscriptions. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscriptions
+func (service *Service) List(ctx context.Context, opts *ListParams) ([]Subscription, *http.Response, error) {
+	subscriptions, _, response, err := woocommerce.CallList[Subscription](ctx, service.client, service.namespace, "/subscriptions", opts)
+
+	return subscriptions, response, err
+}
+
+// Update a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *Service) Update(ctx context.Context, subscriptionID int, subscription *Subscription) (*Subscription, *http.Response, error) {
+	return woocommerce.NamespaceCall[Subscription](ctx, service.client, service.namespace, "PUT", "/subscriptions/"+strconv.Itoa(subscriptionID), nil, subscription)
+}
+
+// Delete a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#delete-a-subscription
+func (service *Service) Delete(ctx context.Context, subscriptionID int, opts *DeleteParams) (*Subscription, *http.Response, error) {
+	return woocommerce.NamespaceCall[Subscription](ctx, service.client, service.namespace, "DELETE", "/subscriptions/"+strconv.Itoa(subscriptionID), opts, nil)
+}
+
+// List the orders of a subscription (parent, renewal, switch and resubscribe orders). Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscription-orders
+func (service *Service) Orders(ctx context.Context, subscriptionID int) ([]woocommerce.Order, *http.Response, error) {
+	_url := "/subscriptions/" + strconv.Itoa(subscriptionID) + "/orders"
+	orders, _, response, err := woocommerce.CallList[woocommerce.Order](ctx, service.client, service.namespace, _url, nil)
+
+	return orders, response, err
+}
+
+// UpdateStatus moves a subscription to another status (eg. StatusOnHold)
+func (service *Service) UpdateStatus(ctx context.Context, subscriptionID int, status string) (*Subscription, *http.Response, error) {
+	subscription := &Subscription{}
+	subscription.Status = status
+
+	return service.Update(ctx, subscriptionID, subscription)
+}
+
+// Activate a subscription
+func (service *Service) Activate(ctx context.Context, subscriptionID int) (*Subscription, *http.Response, error) {
+	return service.UpdateStatus(ctx, subscriptionID, StatusActive)
+}
+
+// PutOnHold suspends a subscription
+func (service *Service) PutOnHold(ctx context.Context, subscriptionID int) (*Subscription, *http.Response, error) {
+	return service.UpdateStatus(ctx, subscriptionID, StatusOnHold)
+}
+
+// Cancel a subscription
+func (service *Service) Cancel(ctx context.Context, subscriptionID int) (*Subscription, *http.Response, error) {
+	return service.UpdateStatus(ctx, subscriptionID, StatusCancelled)
+}
@@ -0,0 +1,240 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// System status service
+type SystemStatusService service
+
+// SystemStatus object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-properties
+type SystemStatus struct {
+	Environment      *SystemStatusEnvironment `json:"environment,omitempty"`
+	Database         *SystemStatusDatabase    `json:"database,omitempty"`
+	ActivePlugins    []SystemStatusPlugin     `json:"active_plugins,omitempty"`
+	InactivePlugins  []SystemStatusPlugin     `json:"inactive_plugins,omitempty"`
+	DropinsMuPlugins *SystemStatusDropins     `json:"dropins_mu_plugins,omitempty"`
+	Theme            *SystemStatusTheme       `json:"theme,omitempty"`
+	Settings         *SystemStatusSettings    `json:"settings,omitempty"`
+	Security         *SystemStatusSecurity    `json:"security,omitempty"`
+	Pages            []SystemStatusPage       `json:"pages,omitempty"`
+	PostTypeCounts   []SystemStatusPostType   `json:"post_type_counts,omitempty"`
+}
+
+type SystemStatusEnvironment struct {
+	HomeUrl                string      `json:"home_url,omitempty"`
+	SiteUrl                string      `json:"site_url,omitempty"`
+	StoreId                string      `json:"store_id,omitempty"`
+	Version                string      `json:"version,omitempty"`
+	LogDirectory           string      `json:"log_directory,omitempty"`
+	LogDirectoryWritable   bool        `json:"log_directory_writable"`
+	WpVersion              string      `json:"wp_version,omitempty"`
+	WpMultisite            bool        `json:"wp_multisite"`
+	WpMemoryLimit          int64       `json:"wp_memory_limit,omitempty"`
+	WpDebugMode            bool        `json:"wp_debug_mode"`
+	WpCron                 bool        `json:"wp_cron"`
+	Language               string      `json:"language,omitempty"`
+	ExternalObjectCache    bool        `json:"external_object_cache"`
+	ServerInfo             string      `json:"server_info,omitempty"`
+	PhpVersion             string      `json:"php_version,omitempty"`
+	PhpPostMaxSize         int64       `json:"php_post_max_size,omitempty"`
+	PhpMaxExecutionTime    int         `json:"php_max_execution_time,omitempty"`
+	PhpMaxInputVars        int         `json:"php_max_input_vars,omitempty"`
+	CurlVersion            string      `json:"curl_version,omitempty"`
+	SuhosinInstalled       bool        `json:"suhosin_installed"`
+	MaxUploadSize          int64       `json:"max_upload_size,omitempty"`
+	MysqlVersion           string      `json:"mysql_version,omitempty"`
+	MysqlVersionString     string      `json:"mysql_version_string,omitempty"`
+	DefaultTimezone        string      `json:"default_timezone,omitempty"`
+	FsockopenOrCurlEnabled bool        `json:"fsockopen_or_curl_enabled"`
+	SoapclientEnabled      bool        `json:"soapclient_enabled"`
+	DomdocumentEnabled     bool        `json:"domdocument_enabled"`
+	GzipEnabled            bool        `json:"gzip_enabled"`
+	MbstringEnabled        bool        `json:"mbstring_enabled"`
+	RemotePostSuccessful   bool        `json:"remote_post_successful"`
+	RemotePostResponse     interface{} `json:"remote_post_response,omitempty"`
+	RemoteGetSuccessful    bool        `json:"remote_get_successful"`
+	RemoteGetResponse      interface{} `json:"remote_get_response,omitempty"`
+}
+
+type SystemStatusDatabase struct {
+	WcDatabaseVersion    string                      `json:"wc_database_version,omitempty"`
+	DatabasePrefix       string                      `json:"database_prefix,omitempty"`
+	MaxmindGeoipDatabase string                      `json:"maxmind_geoip_database,omitempty"`
+	DatabaseTables       *SystemStatusDatabaseTables `json:"database_tables,omitempty"`
+	DatabaseSize         *SystemStatusDatabaseSize   `json:"database_size,omitempty"`
+}
+
+type SystemStatusDatabaseTables struct {
+	WooCommerce map[string]SystemStatusDatabaseTable `json:"woocommerce,omitempty"`
+	Other       map[string]SystemStatusDatabaseTable `json:"other,omitempty"`
+}
+
+// SystemStatusDatabaseTable holds the size of a database table. WooCommerce
+// tables that do not exist are reported as missing.
+type SystemStatusDatabaseTable struct {
+	Data    string `json:"data,omitempty"`
+	Index   string `json:"index,omitempty"`
+	Engine  string `json:"engine,omitempty"`
+	Missing bool   `json:"-"`
+}
+
+type systemStatusDatabaseTable SystemStatusDatabaseTable
+
+type SystemStatusDatabaseSize struct {
+	Data  float64 `json:"data,omitempty"`
+	Index float64 `json:"index,omitempty"`
+}
+
+type SystemStatusPlugin struct {
+	Plugin           string `json:"plugin,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Version          string `json:"version,omitempty"`
+	VersionLatest    string `json:"version_latest,omitempty"`
+	Url              string `json:"url,omitempty"`
+	AuthorName       string `json:"author_name,omitempty"`
+	AuthorUrl        string `json:"author_url,omitempty"`
+	NetworkActivated bool   `json:"network_activated"`
+}
+
+type SystemStatusDropins struct {
+	Dropins   []SystemStatusPlugin `json:"dropins,omitempty"`
+	MuPlugins []SystemStatusPlugin `json:"mu_plugins,omitempty"`
+}
+
+type SystemStatusTheme struct {
+	Name                  string                      `json:"name,omitempty"`
+	Version               string                      `json:"version,omitempty"`
+	VersionLatest         string                      `json:"version_latest,omitempty"`
+	AuthorUrl             string                      `json:"author_url,omitempty"`
+	IsChildTheme          bool                        `json:"is_child_theme"`
+	IsBlockTheme          bool                        `json:"is_block_theme"`
+	HasWoocommerceSupport bool                        `json:"has_woocommerce_support"`
+	HasWoocommerceFile    bool                        `json:"has_woocommerce_file"`
+	HasOutdatedTemplates  bool                        `json:"has_outdated_templates"`
+	Overrides             []SystemStatusThemeOverride `json:"overrides,omitempty"`
+	ParentName            string                      `json:"parent_name,omitempty"`
+	ParentVersion         string                      `json:"parent_version,omitempty"`
+	ParentVersionLatest   string                      `json:"parent_version_latest,omitempty"`
+	ParentAuthorUrl       string                      `json:"parent_author_url,omitempty"`
+}
+
+type SystemStatusThemeOverride struct {
+	File        string `json:"file,omitempty"`
+	Version     string `json:"version,omitempty"`
+	CoreVersion string `json:"core_version,omitempty"`
+}
+
+type SystemStatusSettings struct {
+	ApiEnabled              bool              `json:"api_enabled"`
+	ForceSsl                bool              `json:"force_ssl"`
+	Currency                string            `json:"currency,omitempty"`
+	CurrencySymbol          string            `json:"currency_symbol,omitempty"`
+	CurrencyPosition        string            `json:"currency_position,omitempty"`
+	ThousandSeparator       string            `json:"thousand_separator,omitempty"`
+	DecimalSeparator        string            `json:"decimal_separator,omitempty"`
+	NumberOfDecimals        int               `json:"number_of_decimals"`
+	GeolocationEnabled      bool              `json:"geolocation_enabled"`
+	Taxonomies              map[string]string `json:"taxonomies,omitempty"`
+	ProductVisibilityTerms  map[string]string `json:"product_visibility_terms,omitempty"`
+	WoocommerceComConnected string            `json:"woocommerce_com_connected,omitempty"`
+}
+
+type SystemStatusSecurity struct {
+	SecureConnection bool `json:"secure_connection"`
+	HideErrors       bool `json:"hide_errors"`
+}
+
+type SystemStatusPage struct {
+	PageName          string      `json:"page_name,omitempty"`
+	PageId            interface{} `json:"page_id,omitempty"`
+	PageSet           bool        `json:"page_set"`
+	PageExists        bool        `json:"page_exists"`
+	PageVisible       bool        `json:"page_visible"`
+	Shortcode         string      `json:"shortcode,omitempty"`
+	Block             string      `json:"block,omitempty"`
+	ShortcodeRequired bool        `json:"shortcode_required"`
+	ShortcodePresent  bool        `json:"shortcode_present"`
+	BlockPresent      bool        `json:"block_present"`
+	BlockRequired     bool        `json:"block_required"`
+}
+
+type SystemStatusPostType struct {
+	Type  string      `json:"type,omitempty"`
+	Count interface{} `json:"count,omitempty"`
+}
+
+func (table *SystemStatusDatabaseTable) UnmarshalJSON(data []byte) error {
+	// Missing tables are reported as false
+	if len(data) > 0 && data[0] != '{' {
+		*table = SystemStatusDatabaseTable{Missing: true}
+
+		return nil
+	}
+
+	return json.Unmarshal(data, (*systemStatusDatabaseTable)(table))
+}
+
+// OutdatedPlugins returns the active plugins that have a newer version available
+func (status *SystemStatus) OutdatedPlugins() []SystemStatusPlugin {
+	var plugins []SystemStatusPlugin
+
+	for _, plugin := range status.ActivePlugins {
+		if plugin.VersionLatest != "" && plugin.VersionLatest != plugin.Version {
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	return plugins
+}
+
+// MissingTables returns the names of the WooCommerce database tables that do not exist
+func (status *SystemStatus) MissingTables() []string {
+	if status.Database == nil || status.Database.DatabaseTables == nil {
+		return nil
+	}
+
+	var tables []string
+
+	for _, name := range sortedKeys(status.Database.DatabaseTables.WooCommerce) {
+		if status.Database.DatabaseTables.WooCommerce[name].Missing {
+			tables = append(tables, name)
+		}
+	}
+
+	return tables
+}
+
+// MissingPages returns the WooCommerce pages that are not set, do not exist or
+// lack their required shortcode or block
+func (status *SystemStatus) MissingPages() []SystemStatusPage {
+	var pages []SystemStatusPage
+
+	for _, page := range status.Pages {
+		missingContent := (page.ShortcodeRequired && !page.ShortcodePresent) || (page.BlockRequired && !page.BlockPresent)
+
+		if !page.PageSet || !page.PageExists || missingContent {
+			pages = append(pages, page)
+		}
+	}
+
+	return pages
+}
+
+// Get the system status. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-system-status-items
+func (service *SystemStatusService) Get() (*SystemStatus, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/system_status", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(SystemStatus)
+	response, err := service.client.Do(req, status)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
@@ -0,0 +1,86 @@
+package woocommerce
+
+import "net/http"
+
+// System status tools service
+type SystemStatusToolsService service
+
+// SystemStatusTool object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-tool-properties
+type SystemStatusTool struct {
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Action      string `json:"action,omitempty"`
+	Description string `json:"description,omitempty"`
+	Success     bool   `json:"success,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Confirm     bool   `json:"confirm,omitempty"`
+	Links       *Links `json:"_links,omitempty"`
+}
+
+const (
+	SystemStatusToolClearTransients                 = "clear_transients"
+	SystemStatusToolClearExpiredTransients          = "clear_expired_transients"
+	SystemStatusToolDeleteOrphanedVariations        = "delete_orphaned_variations"
+	SystemStatusToolClearExpiredDownloadPermissions = "clear_expired_download_permissions"
+	SystemStatusToolRegenerateProductLookupTables   = "regenerate_product_lookup_tables"
+	SystemStatusToolRecountTerms                    = "recount_terms"
+	SystemStatusToolResetRoles                      = "reset_roles"
+	SystemStatusToolClearSessions                   = "clear_sessions"
+	SystemStatusToolClearTemplateCache              = "clear_template_cache"
+	SystemStatusToolInstallPages                    = "install_pages"
+	SystemStatusToolDeleteTaxes                     = "delete_taxes"
+	SystemStatusToolRegenerateThumbnails            = "regenerate_thumbnails"
+	SystemStatusToolDbUpdateRoutine                 = "db_update_routine"
+	SystemStatusToolVerifyDbTables                  = "verify_db_tables"
+)
+
+// Get a system status tool. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-tool-from-system-status
+func (service *SystemStatusToolsService) Get(toolID string) (*SystemStatusTool, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/system_status/tools/"+toolID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tool := new(SystemStatusTool)
+	response, err := service.client.Do(req, tool)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tool, response, nil
+}
+
+// List all system status tools. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tools-from-system-status
+func (service *SystemStatusToolsService) List() ([]SystemStatusTool, *http.Response, error) {
+	req, err := service.client.NewRequest("GET", "/system_status/tools", nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tools []SystemStatusTool
+	response, err := service.client.Do(req, &tools)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tools, response, nil
+}
+
+// Run a system status tool, the returned tool holds the run result. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#run-a-tool-from-system-status
+func (service *SystemStatusToolsService) Run(toolID string) (*SystemStatusTool, *http.Response, error) {
+	req, err := service.client.NewRequest("PUT", "/system_status/tools/"+toolID, nil, &SystemStatusTool{Confirm: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tool := new(SystemStatusTool)
+	response, err := service.client.Do(req, tool)
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tool, response, nil
+}
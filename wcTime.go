@@ -0,0 +1,107 @@
+package woocommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+// WCTimeLayout is the ISO-8601 layout of WooCommerce dates, without timezone
+const WCTimeLayout = "2006-01-02T15:04:05"
+
+// Layouts of decoded dates: some endpoints include the offset (eg.
+// "2024-01-02T10:00:00+00:00"), and analytics use a space (eg. "2019-03-25 00:00:00")
+var wcTimeLayouts = []string{WCTimeLayout, time.RFC3339, time.DateTime}
+
+// WCTime is a WooCommerce date. WooCommerce dates have no timezone: *_gmt
+// fields are in UTC and the others in the site timezone, so decoded times are
+// in UTC and site-local ones should be paired with their *_gmt field (see
+// PairWCTime). Null and empty dates decode to the zero time.
+//
+// Times are encoded with the wall clock of their location, so set *_gmt fields
+// with UTC times. A zero time is encoded as null, which clears the date.
+type WCTime struct {
+	time.Time
+}
+
+// NewWCTime returns a date for a *_gmt field
+func NewWCTime(t time.Time) *WCTime {
+	return &WCTime{Time: t.UTC()}
+}
+
+// PairWCTime returns the time of a site-local date and its *_gmt counterpart
+// (eg. order.DateCreated and order.DateCreatedGmt), in the site UTC offset.
+// When one of them is missing the other one is returned as decoded.
+func PairWCTime(local *WCTime, gmt *WCTime) time.Time {
+	if gmt.IsZero() {
+		if local.IsZero() {
+			return time.Time{}
+		}
+
+		return local.Time
+	}
+
+	if local.IsZero() {
+		return gmt.Time
+	}
+
+	// Site offset, to the minute (WordPress offsets can be fractional hours)
+	offset := local.Time.Sub(gmt.Time).Round(time.Minute)
+
+	return gmt.Time.In(time.FixedZone("", int(offset.Seconds())))
+}
+
+// IsZero reports whether the date is unset, it can be called on a nil date
+func (t *WCTime) IsZero() bool {
+	return t == nil || t.Time.IsZero()
+}
+
+func (t WCTime) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Time.Format(WCTimeLayout))
+}
+
+// EncodeValues encodes the date as a query parameter (eg. AfterGmt in list
+// parameters), converted to UTC. Zero dates are omitted.
+func (t WCTime) EncodeValues(key string, values *url.Values) error {
+	if !t.Time.IsZero() {
+		values.Add(key, t.Time.UTC().Format(WCTimeLayout))
+	}
+
+	return nil
+}
+
+func (t *WCTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	if value == "" {
+		t.Time = time.Time{}
+
+		return nil
+	}
+
+	var err error
+	for _, layout := range wcTimeLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			t.Time = parsed
+
+			return nil
+		}
+	}
+
+	return err
+}
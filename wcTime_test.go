@@ -0,0 +1,105 @@
+package woocommerce
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestWCTimeUnmarshal(t *testing.T) {
+	want := time.Date(2019, 3, 25, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		json string
+		want time.Time
+		err  bool
+	}{
+		{json: `"2019-03-25T10:30:00"`, want: want},
+		{json: `"2019-03-25T10:30:00+00:00"`, want: want},
+		{json: `"2019-03-25T12:30:00+02:00"`, want: want},
+		{json: `"2019-03-25 10:30:00"`, want: want},
+		{json: `null`},
+		{json: `""`},
+		{json: `"25/03/2019"`, err: true},
+		{json: `12`, err: true},
+	}
+
+	for _, test := range tests {
+		var got WCTime
+		err := json.Unmarshal([]byte(test.json), &got)
+
+		if test.err {
+			if err == nil {
+				t.Errorf("unmarshal %s = %v, want an error", test.json, got.Time)
+			}
+
+			continue
+		}
+
+		if err != nil || !got.Time.Equal(test.want) {
+			t.Errorf("unmarshal %s = %v, %v, want %v", test.json, got.Time, err, test.want)
+		}
+	}
+}
+
+func TestWCTimeMarshal(t *testing.T) {
+	tests := []struct {
+		time *WCTime
+		want string
+	}{
+		{time: &WCTime{Time: time.Date(2019, 3, 25, 10, 30, 0, 0, time.UTC)}, want: `{"date":"2019-03-25T10:30:00"}`},
+		{time: NewWCTime(time.Date(2019, 3, 25, 12, 30, 0, 0, time.FixedZone("", 2*3600))), want: `{"date":"2019-03-25T10:30:00"}`},
+		{time: &WCTime{}, want: `{"date":null}`},
+		{time: nil, want: `{}`},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(struct {
+			Date *WCTime `json:"date,omitempty"`
+		}{Date: test.time})
+
+		if err != nil || string(data) != test.want {
+			t.Errorf("marshal %v = %s, %v, want %s", test.time, data, err, test.want)
+		}
+	}
+}
+
+func TestAnalyticsIntervalDates(t *testing.T) {
+	var interval AnalyticsInterval[RevenueStatsTotals]
+
+	data := `{"interval":"2019-13","date_start":"2019-03-25 00:00:00","date_start_gmt":"2019-03-25 00:00:00","date_end":"2019-03-31 23:59:59","date_end_gmt":"2019-03-31 23:59:59","subtotals":{}}`
+	if err := json.Unmarshal([]byte(data), &interval); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Date(2019, 3, 31, 23, 59, 59, 0, time.UTC); !interval.DateEndGmt.Time.Equal(want) {
+		t.Errorf("date_end_gmt = %v, want %v", interval.DateEndGmt.Time, want)
+	}
+}
+
+func TestWCTimeQuery(t *testing.T) {
+	params := &ListOrdersParams{
+		AfterGmt:    NewWCTime(time.Date(2024, 1, 2, 12, 0, 0, 0, time.FixedZone("", 2*3600))),
+		BeforeGmt:   &WCTime{Time: time.Date(2024, 1, 2, 12, 0, 0, 0, time.FixedZone("", 2*3600))},
+		DatesAreGTM: true,
+	}
+
+	values, err := query.Values(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := values.Get("after"); got != "2024-01-02T10:00:00" {
+		t.Errorf("after = %q, want the UTC time", got)
+	}
+
+	if got := values.Get("before"); got != "2024-01-02T10:00:00" {
+		t.Errorf("before = %q, want the UTC time", got)
+	}
+
+	if values.Has("modified_after") {
+		t.Errorf("modified_after = %q, want it omitted", values.Get("modified_after"))
+	}
+}
@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+// CapturedDelivery is a webhook delivery as received, one per line of a
+// capture file (JSONL)
+type CapturedDelivery struct {
+	Time    time.Time   `json:"time"`
+	Headers http.Header `json:"headers"`
+	Body    string      `json:"body"`
+}
+
+// Capture returns a handler writing every received delivery to w as a JSON
+// line, before passing it to next. Deliveries are acknowledged when next is nil,
+// and rejected without being written when larger than the Receiver accepts.
+func Capture(w io.Writer, next http.Handler) http.Handler {
+	var mu sync.Mutex
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxDeliveryBodyBytes+1))
+		if err != nil {
+			http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		if len(body) > maxDeliveryBodyBytes {
+			http.Error(rw, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		// Keep HTML of bodies readable (and lines short) rather than escaped
+		line := new(bytes.Buffer)
+		encoder := json.NewEncoder(line)
+		encoder.SetEscapeHTML(false)
+
+		if err := encoder.Encode(&CapturedDelivery{Time: time.Now().UTC(), Headers: r.Header, Body: string(body)}); err != nil {
+			http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		mu.Lock()
+		_, err = w.Write(line.Bytes())
+		mu.Unlock()
+
+		if err != nil {
+			http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if next == nil {
+			rw.WriteHeader(http.StatusOK)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// ReadCaptured reads the deliveries of a capture file
+func ReadCaptured(r io.Reader) ([]CapturedDelivery, error) {
+	var deliveries []CapturedDelivery
+
+	// Decode a stream rather than lines, so that deliveries have no size limit
+	decoder := json.NewDecoder(r)
+
+	for {
+		var delivery CapturedDelivery
+
+		err := decoder.Decode(&delivery)
+		if err == io.EOF {
+			return deliveries, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+}
+
+// NewReplayRequest builds a request replaying a captured delivery to a
+// webhook delivery URL. The delivery is re-signed with the webhook secret
+// when set, and re-attributed to the webhook ID when set.
+func NewReplayRequest(ctx context.Context, target *woocommerce.Webhook, delivery *CapturedDelivery) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.DeliveryUrl, bytes.NewReader([]byte(delivery.Body)))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = delivery.Headers.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+
+	// Not forwarded: set by the HTTP client for the new request
+	req.Header.Del("Content-Length")
+	req.Header.Del("Accept-Encoding")
+
+	if target.Secret != "" {
+		req.Header.Set(woocommerce.WebhookHeaderSignature, woocommerce.ComputeSignature([]byte(delivery.Body), target.Secret))
+	}
+
+	if target.Id != 0 {
+		req.Header.Set(woocommerce.WebhookHeaderID, strconv.Itoa(target.Id))
+	}
+
+	return req, nil
+}
+
+// Replay sends a captured delivery to a webhook delivery URL, see
+// NewReplayRequest. The response body is closed.
+func Replay(ctx context.Context, httpClient *http.Client, target *woocommerce.Webhook, delivery *CapturedDelivery) (*http.Response, error) {
+	req, err := NewReplayRequest(ctx, target, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	io.Copy(io.Discard, response.Body)
+	response.Body.Close()
+
+	return response, nil
+}
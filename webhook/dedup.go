@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+// Default time delivery IDs are remembered, well beyond WooCommerce retries
+const defaultDeliveryTTL = 24 * time.Hour
+
+// DedupStore remembers processed deliveries so that handlers run once per
+// logical change. Deliveries are keyed on their X-WC-Webhook-Delivery-ID, and
+// resources on their last processed modification time per topic.
+type DedupStore interface {
+	// SeenDelivery tells whether a delivery ID was already processed
+	SeenDelivery(deliveryID string) (bool, error)
+
+	// LastModified returns the last processed modification time of a resource
+	// for a topic (eg. "order.updated:12")
+	LastModified(resourceKey string) (time.Time, bool, error)
+
+	// MarkProcessed records a processed delivery and, when known, the
+	// modification time of its resource
+	MarkProcessed(deliveryID string, resourceKey string, modified time.Time) error
+}
+
+// deliveryResource holds the fields of a delivery body used for deduplication
+type deliveryResource struct {
+	Id              int                 `json:"id"`
+	DateModifiedGmt *woocommerce.WCTime `json:"date_modified_gmt"`
+}
+
+// resourceIdentity returns the resource key (eg. "order:12"), dedup key (eg.
+// "order.updated:12") and modification time of a delivery resource. The dedup
+// key includes the topic, so that an update sharing the modification second
+// of a creation is still processed. Bodies without modification time (eg.
+// deleted resources) have a zero time and are only deduplicated on their
+// delivery ID.
+func resourceIdentity(delivery *woocommerce.WebhookDelivery, body []byte) (string, string, time.Time) {
+	var resource deliveryResource
+	if err := json.Unmarshal(body, &resource); err != nil || resource.Id == 0 {
+		return "", "", time.Time{}
+	}
+
+	id := strconv.Itoa(resource.Id)
+
+	resourceKey := string(delivery.Resource) + ":" + id
+	dedupKey := string(delivery.Topic) + ":" + id
+
+	if resource.DateModifiedGmt.IsZero() {
+		return resourceKey, dedupKey, time.Time{}
+	}
+
+	return resourceKey, dedupKey, resource.DateModifiedGmt.Time
+}
+
+// MemoryStore is an in-memory DedupStore and CheckpointStore
+type MemoryStore struct {
+	// DeliveryTTL is how long delivery IDs, and the modification times of
+	// resources not modified since, are remembered (default 24 hours)
+	DeliveryTTL time.Duration
+
+	mu          sync.Mutex
+	deliveries  map[string]time.Time
+	modified    map[string]time.Time
+	checkpoints map[string]*Checkpoint
+	pruned      time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		DeliveryTTL: defaultDeliveryTTL,
+		deliveries:  map[string]time.Time{},
+		modified:    map[string]time.Time{},
+		checkpoints: map[string]*Checkpoint{},
+	}
+}
+
+func (store *MemoryStore) SeenDelivery(deliveryID string) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	processed, ok := store.deliveries[deliveryID]
+
+	return ok && time.Since(processed) < store.deliveryTTL(), nil
+}
+
+func (store *MemoryStore) LastModified(resourceKey string) (time.Time, bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	modified, ok := store.modified[resourceKey]
+
+	return modified, ok, nil
+}
+
+func (store *MemoryStore) MarkProcessed(deliveryID string, resourceKey string, modified time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.mark(deliveryID, resourceKey, modified)
+
+	return nil
+}
+
+func (store *MemoryStore) mark(deliveryID string, resourceKey string, modified time.Time) {
+	now := time.Now()
+
+	if deliveryID != "" {
+		store.deliveries[deliveryID] = now
+	}
+
+	// Forget expired delivery IDs and resources not modified since (at most
+	// once a minute)
+	if now.Sub(store.pruned) >= time.Minute {
+		for id, processed := range store.deliveries {
+			if now.Sub(processed) >= store.deliveryTTL() {
+				delete(store.deliveries, id)
+			}
+		}
+
+		for key, modified := range store.modified {
+			if now.Sub(modified) >= store.deliveryTTL() {
+				delete(store.modified, key)
+			}
+		}
+
+		store.pruned = now
+	}
+
+	if resourceKey != "" && !modified.IsZero() && modified.After(store.modified[resourceKey]) {
+		store.modified[resourceKey] = modified
+	}
+}
+
+func (store *MemoryStore) deliveryTTL() time.Duration {
+	if store.DeliveryTTL <= 0 {
+		return defaultDeliveryTTL
+	}
+
+	return store.DeliveryTTL
+}
+
+func (store *MemoryStore) LoadCheckpoint(feed string) (*Checkpoint, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.checkpoints[feed].clone(), nil
+}
+
+func (store *MemoryStore) SaveCheckpoint(feed string, checkpoint *Checkpoint) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.checkpoints[feed] = checkpoint.clone()
+
+	return nil
+}
+
+// FileStore is a DedupStore and CheckpointStore persisted to a JSON file,
+// rewritten on every processed delivery and saved checkpoint. Expired delivery
+// IDs and modification times are dropped (see MemoryStore.DeliveryTTL), which
+// bounds the file size.
+type FileStore struct {
+	path   string
+	memory *MemoryStore
+}
+
+type fileStoreData struct {
+	Deliveries  map[string]time.Time   `json:"deliveries"`
+	Modified    map[string]time.Time   `json:"modified"`
+	Checkpoints map[string]*Checkpoint `json:"checkpoints,omitempty"`
+}
+
+// NewFileStore opens a file backed store, loading the file when it exists
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{path: path, memory: NewMemoryStore()}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var saved fileStoreData
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, err
+	}
+
+	for deliveryID, processed := range saved.Deliveries {
+		store.memory.deliveries[deliveryID] = processed
+	}
+
+	for key, modified := range saved.Modified {
+		store.memory.modified[key] = modified
+	}
+
+	for feed, checkpoint := range saved.Checkpoints {
+		store.memory.checkpoints[feed] = checkpoint
+	}
+
+	return store, nil
+}
+
+func (store *FileStore) SeenDelivery(deliveryID string) (bool, error) {
+	return store.memory.SeenDelivery(deliveryID)
+}
+
+func (store *FileStore) LastModified(resourceKey string) (time.Time, bool, error) {
+	return store.memory.LastModified(resourceKey)
+}
+
+func (store *FileStore) MarkProcessed(deliveryID string, resourceKey string, modified time.Time) error {
+	store.memory.mu.Lock()
+	defer store.memory.mu.Unlock()
+
+	store.memory.mark(deliveryID, resourceKey, modified)
+
+	return store.save()
+}
+
+func (store *FileStore) LoadCheckpoint(feed string) (*Checkpoint, error) {
+	return store.memory.LoadCheckpoint(feed)
+}
+
+func (store *FileStore) SaveCheckpoint(feed string, checkpoint *Checkpoint) error {
+	store.memory.mu.Lock()
+	defer store.memory.mu.Unlock()
+
+	store.memory.checkpoints[feed] = checkpoint.clone()
+
+	return store.save()
+}
+
+// SetDeliveryTTL sets how long delivery IDs and modification times are
+// remembered (default 24 hours)
+func (store *FileStore) SetDeliveryTTL(ttl time.Duration) {
+	store.memory.mu.Lock()
+	defer store.memory.mu.Unlock()
+
+	store.memory.DeliveryTTL = ttl
+}
+
+// save writes the store to its file, the memory store lock must be held
+func (store *FileStore) save() error {
+	saved := fileStoreData{Deliveries: store.memory.deliveries, Modified: store.memory.modified, Checkpoints: store.memory.checkpoints}
+
+	data, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that the store is never left truncated
+	tmp, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), store.path)
+}
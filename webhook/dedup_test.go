@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+const testSecret = "secret"
+
+func deliver(t *testing.T, receiver *Receiver, topic string, deliveryID string, body string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(woocommerce.WebhookHeaderTopic, topic)
+	req.Header.Set(woocommerce.WebhookHeaderResource, strings.Split(topic, ".")[0])
+	req.Header.Set(woocommerce.WebhookHeaderDeliveryID, deliveryID)
+	req.Header.Set(woocommerce.WebhookHeaderSignature, woocommerce.ComputeSignature([]byte(body), testSecret))
+
+	recorder := httptest.NewRecorder()
+	receiver.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("delivery %s: status %d", deliveryID, recorder.Code)
+	}
+}
+
+func TestDedupCreatedThenUpdatedSameSecond(t *testing.T) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "dedup.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receiver := NewReceiver(testSecret)
+	receiver.Dedup = store
+
+	calls := map[woocommerce.WebhookTopic]int{}
+	receiver.OnOrder("order.*", func(ctx context.Context, delivery *woocommerce.WebhookDelivery, order *woocommerce.Order) error {
+		calls[delivery.Topic]++
+		return nil
+	})
+
+	body := `{"id":7,"date_modified_gmt":"2024-01-01T10:00:00"}`
+
+	deliver(t, receiver, "order.created", "a", body)
+	deliver(t, receiver, "order.updated", "b", body)
+
+	if calls["order.created"] != 1 || calls["order.updated"] != 1 {
+		t.Fatalf("expected one call per topic, got %v", calls)
+	}
+
+	// Redelivery and duplicate update of the same change
+	deliver(t, receiver, "order.updated", "b", body)
+	deliver(t, receiver, "order.updated", "c", body)
+
+	// Stale update, delivered out of order
+	deliver(t, receiver, "order.updated", "d", `{"id":7,"date_modified_gmt":"2024-01-01T09:00:00"}`)
+
+	if calls["order.updated"] != 1 {
+		t.Fatalf("expected duplicate and stale updates to be dropped, got %v", calls)
+	}
+
+	deliver(t, receiver, "order.updated", "e", `{"id":7,"date_modified_gmt":"2024-01-01T10:00:01"}`)
+
+	if calls["order.updated"] != 2 {
+		t.Fatalf("expected newer update to be processed, got %v", calls)
+	}
+}
+
+func TestMemoryStoreDeliveryTTL(t *testing.T) {
+	store := NewMemoryStore()
+	store.DeliveryTTL = time.Millisecond
+
+	if err := store.MarkProcessed("a", "order.updated:12", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	if seen, _ := store.SeenDelivery("a"); seen {
+		t.Fatal("expected expired delivery to be forgotten")
+	}
+
+	// Force the next mark to prune
+	store.pruned = time.Time{}
+
+	if err := store.MarkProcessed("b", "", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := store.deliveries["a"]; ok {
+		t.Fatal("expected expired delivery to be pruned")
+	}
+
+	if _, ok, _ := store.LastModified("order.updated:12"); ok {
+		t.Fatal("expected expired modification time to be pruned")
+	}
+}
@@ -0,0 +1,276 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+const (
+	defaultPollerInterval = time.Minute
+	defaultPollerOverlap  = 5 * time.Minute
+)
+
+// Checkpoint is the persisted position of a change feed: the high-water mark
+// of the resource modification times, and the resources processed near it
+type Checkpoint struct {
+	Mark time.Time         `json:"mark"`
+	Seen map[int]time.Time `json:"seen,omitempty"`
+}
+
+func (checkpoint *Checkpoint) clone() *Checkpoint {
+	if checkpoint == nil {
+		return nil
+	}
+
+	clone := &Checkpoint{Mark: checkpoint.Mark, Seen: make(map[int]time.Time, len(checkpoint.Seen))}
+	for id, modified := range checkpoint.Seen {
+		clone.Seen[id] = modified
+	}
+
+	return clone
+}
+
+// CheckpointStore persists change feed checkpoints, keyed by feed (eg. "order")
+type CheckpointStore interface {
+	// LoadCheckpoint returns the checkpoint of a feed, or nil if none
+	LoadCheckpoint(feed string) (*Checkpoint, error)
+
+	SaveCheckpoint(feed string, checkpoint *Checkpoint) error
+}
+
+// Poller is a change feed for stores that cannot send webhooks. It lists the
+// resources modified since the last poll and dispatches them to the handlers
+// of a Receiver, as "<resource>.created" or "<resource>.updated" deliveries.
+//
+// Each poll lists resources modified after the high-water mark minus an
+// overlap, ordered by ID, and skips the ones already processed with the same
+// modification time. Resources sharing a timestamp, modified during a poll or
+// shifted across pages are thus neither missed nor dispatched twice. Deleted
+// resources are not listed by the API, so no deleted events are dispatched.
+//
+// Feeds without checkpoint start from StartFrom. When it is zero, the first
+// poll backfills: all existing resources are dispatched as created.
+//
+// The customers API cannot filter on modification time, so all customers are
+// listed on every poll and the unchanged ones skipped: leave customers out of
+// Resources or raise the Interval for stores with many customers.
+type Poller struct {
+	client   *woocommerce.Client
+	receiver *Receiver
+	store    CheckpointStore
+
+	// Resources to poll (default: coupons, customers, orders and products)
+	Resources []woocommerce.WebhookResource
+
+	// Interval between polls (default 1 minute)
+	Interval time.Duration
+
+	// StartFrom is the high-water mark of feeds without checkpoint (eg.
+	// time.Now() to only dispatch changes from now on). Resources modified
+	// before it, minus the Overlap, are not dispatched. Zero backfills all
+	// resources.
+	StartFrom time.Time
+
+	// Overlap re-lists resources modified shortly before the high-water mark,
+	// to catch writes committed during a previous poll (default 5 minutes)
+	Overlap time.Duration
+
+	// OnError is called when a poll fails in Run, and when a resource without
+	// date is skipped
+	OnError func(err error)
+}
+
+// feedResource holds the fields of a listed resource used by the poller
+type feedResource struct {
+	Id              int                 `json:"id"`
+	DateCreatedGmt  *woocommerce.WCTime `json:"date_created_gmt"`
+	DateModifiedGmt *woocommerce.WCTime `json:"date_modified_gmt"`
+}
+
+// NewPoller creates a poller dispatching changes to the receiver handlers
+// and persisting its checkpoints in the store
+func NewPoller(client *woocommerce.Client, receiver *Receiver, store CheckpointStore) *Poller {
+	return &Poller{
+		client:   client,
+		receiver: receiver,
+		store:    store,
+		Resources: []woocommerce.WebhookResource{
+			woocommerce.WebhookResourceCoupon,
+			woocommerce.WebhookResourceCustomer,
+			woocommerce.WebhookResourceOrder,
+			woocommerce.WebhookResourceProduct,
+		},
+		Interval: defaultPollerInterval,
+		Overlap:  defaultPollerOverlap,
+	}
+}
+
+// Run polls every interval until the context is done. Poll errors are passed
+// to OnError and do not stop the poller.
+func (poller *Poller) Run(ctx context.Context) error {
+	interval := poller.Interval
+	if interval <= 0 {
+		interval = defaultPollerInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := poller.Poll(ctx)
+		if err != nil && ctx.Err() == nil && poller.OnError != nil {
+			poller.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll dispatches the changes of all resources since the last poll
+func (poller *Poller) Poll(ctx context.Context) error {
+	for _, resource := range poller.Resources {
+		if err := poller.PollResource(ctx, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PollResource dispatches the changes of a resource since the last poll. When
+// a handler fails the resources processed so far are saved, and the poll is
+// resumed from there on the next call.
+func (poller *Poller) PollResource(ctx context.Context, resource woocommerce.WebhookResource) error {
+	feed := string(resource)
+
+	checkpoint, err := poller.store.LoadCheckpoint(feed)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{Mark: poller.StartFrom.UTC()}
+	}
+
+	if checkpoint.Seen == nil {
+		checkpoint.Seen = map[int]time.Time{}
+	}
+
+	var since time.Time
+	if !checkpoint.Mark.IsZero() {
+		since = checkpoint.Mark.Add(-poller.Overlap)
+	}
+
+	path, query, err := feedQuery(resource, since)
+	if err != nil {
+		return err
+	}
+
+	mark := checkpoint.Mark
+
+	for body, err := range woocommerce.Paginate[json.RawMessage](ctx, poller.client, poller.client.DefaultNamespace(), path, query) {
+		if err != nil {
+			return err
+		}
+
+		var item feedResource
+		if err := json.Unmarshal(body, &item); err != nil {
+			return err
+		}
+
+		// Never modified? (eg. some imported resources have a null modification date)
+		date := item.DateModifiedGmt
+		if date.IsZero() {
+			date = item.DateCreatedGmt
+		}
+
+		if date.IsZero() {
+			if poller.OnError != nil {
+				poller.OnError(fmt.Errorf("skipped %s %d without modification and creation dates", resource, item.Id))
+			}
+
+			continue
+		}
+
+		modified := date.Time
+
+		// Not modified since? (customers cannot be filtered by the API)
+		if !since.IsZero() && modified.Before(since) {
+			continue
+		}
+
+		previous, seen := checkpoint.Seen[item.Id]
+		if seen && !modified.After(previous) {
+			continue
+		}
+
+		event := woocommerce.WebhookEventUpdated
+
+		if created := item.DateCreatedGmt; !created.IsZero() && !seen && !created.Before(since) {
+			event = woocommerce.WebhookEventCreated
+		}
+
+		delivery := &woocommerce.WebhookDelivery{
+			Topic:    woocommerce.NewWebhookTopic(resource, event),
+			Resource: resource,
+			Event:    event,
+		}
+
+		if err := poller.receiver.process(ctx, delivery, body); err != nil {
+			// Keep the processed resources (the mark only moves on complete polls)
+			if saveErr := poller.store.SaveCheckpoint(feed, checkpoint); saveErr != nil {
+				return saveErr
+			}
+
+			return err
+		}
+
+		checkpoint.Seen[item.Id] = modified
+
+		if modified.After(mark) {
+			mark = modified
+		}
+	}
+
+	checkpoint.Mark = mark
+
+	// Forget resources out of the next poll window
+	for id, modified := range checkpoint.Seen {
+		if modified.Before(mark.Add(-poller.Overlap)) {
+			delete(checkpoint.Seen, id)
+		}
+	}
+
+	return poller.store.SaveCheckpoint(feed, checkpoint)
+}
+
+// feedQuery returns the list endpoint and query of a resource, ordered by ID
+// so that pages are stable while resources are modified
+func feedQuery(resource woocommerce.WebhookResource, since time.Time) (string, interface{}, error) {
+	var modifiedAfter *woocommerce.WCTime
+	if !since.IsZero() {
+		// Inclusive of the overlap start: a second earlier
+		modifiedAfter = woocommerce.NewWCTime(since.Add(-time.Second))
+	}
+
+	switch resource {
+	case woocommerce.WebhookResourceCoupon:
+		return "/coupons", &woocommerce.ListCouponParams{OrderBy: "id", Order: "asc", ModifiedAfterGmt: modifiedAfter, DatesAreGmt: true}, nil
+	case woocommerce.WebhookResourceCustomer:
+		return "/customers", &woocommerce.ListCustomerParams{OrderBy: "id", Order: "asc", Role: "all"}, nil
+	case woocommerce.WebhookResourceOrder:
+		return "/orders", &woocommerce.ListOrdersParams{OrderBy: "id", Order: "asc", ModifiedAfterGmt: modifiedAfter, DatesAreGTM: true}, nil
+	case woocommerce.WebhookResourceProduct:
+		return "/products", &woocommerce.ListProductParams{OrderBy: "id", Order: "asc", ModifiedAfterGmt: modifiedAfter, DatesAreGmt: true}, nil
+	}
+
+	return "", nil, fmt.Errorf("cannot poll %q resources", resource)
+}
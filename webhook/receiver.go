@@ -0,0 +1,223 @@
+// Package webhook receives WooCommerce webhook deliveries: it verifies their
+// signature, answers the ping sent when a webhook is created and dispatches
+// the decoded resource to handlers registered per topic. Stores that cannot
+// send webhooks can feed the same handlers with a Poller.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"sync"
+
+	woocommerce "github.com/sparklayer-io/go-woocommerce-api"
+)
+
+const (
+	maxDeliveryBodyBytes = 10 << 20
+	resourceLockStripes  = 64
+)
+
+var errorDeliveryBodyTooLarge = errors.New("webhook delivery body is too large")
+
+// HandlerFunc handles the raw body of a delivery
+type HandlerFunc func(ctx context.Context, delivery *woocommerce.WebhookDelivery, body []byte) error
+
+// Receiver is an http.Handler receiving webhook deliveries
+type Receiver struct {
+	secret string
+
+	mu       sync.RWMutex
+	handlers map[woocommerce.WebhookTopic]HandlerFunc
+
+	// Deliveries of the same resource are processed one at a time
+	resourceLocks [resourceLockStripes]sync.Mutex
+
+	// OnError is called when a delivery is rejected or its handler fails
+	OnError func(delivery *woocommerce.WebhookDelivery, err error)
+
+	// Dedup, when set, drops deliveries already processed and stale
+	// deliveries of resources modified since
+	Dedup DedupStore
+}
+
+// NewReceiver creates a receiver verifying deliveries with the webhook secret
+func NewReceiver(secret string) *Receiver {
+	return &Receiver{secret: secret, handlers: map[woocommerce.WebhookTopic]HandlerFunc{}}
+}
+
+// Handle registers a handler for a topic. Topics can be exact (eg.
+// "order.created"), match all events of a resource (eg. "order.*") or match
+// all topics ("*"). The most specific handler is called.
+func (receiver *Receiver) Handle(topic woocommerce.WebhookTopic, handler HandlerFunc) {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	receiver.handlers[topic] = handler
+}
+
+// On registers a handler receiving the delivery body decoded into a T
+func On[T any](receiver *Receiver, topic woocommerce.WebhookTopic, handler func(ctx context.Context, delivery *woocommerce.WebhookDelivery, resource *T) error) {
+	receiver.Handle(topic, func(ctx context.Context, delivery *woocommerce.WebhookDelivery, body []byte) error {
+		resource := new(T)
+		if err := json.Unmarshal(body, resource); err != nil {
+			return err
+		}
+
+		return handler(ctx, delivery, resource)
+	})
+}
+
+// OnOrder registers a handler for an order topic (eg. "order.created" or "order.*")
+func (receiver *Receiver) OnOrder(topic woocommerce.WebhookTopic, handler func(ctx context.Context, delivery *woocommerce.WebhookDelivery, order *woocommerce.Order) error) {
+	On(receiver, topic, handler)
+}
+
+// OnProduct registers a handler for a product topic (eg. "product.updated" or "product.*")
+func (receiver *Receiver) OnProduct(topic woocommerce.WebhookTopic, handler func(ctx context.Context, delivery *woocommerce.WebhookDelivery, product *woocommerce.Product) error) {
+	On(receiver, topic, handler)
+}
+
+// OnCustomer registers a handler for a customer topic (eg. "customer.created" or "customer.*")
+func (receiver *Receiver) OnCustomer(topic woocommerce.WebhookTopic, handler func(ctx context.Context, delivery *woocommerce.WebhookDelivery, customer *woocommerce.Customer) error) {
+	On(receiver, topic, handler)
+}
+
+// OnCoupon registers a handler for a coupon topic (eg. "coupon.deleted" or "coupon.*")
+func (receiver *Receiver) OnCoupon(topic woocommerce.WebhookTopic, handler func(ctx context.Context, delivery *woocommerce.WebhookDelivery, coupon *woocommerce.Coupon) error) {
+	On(receiver, topic, handler)
+}
+
+func (receiver *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	delivery := woocommerce.ParseWebhookHeaders(r.Header)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxDeliveryBodyBytes+1))
+	if err != nil {
+		receiver.fail(w, delivery, err, http.StatusBadRequest)
+		return
+	}
+
+	if len(body) > maxDeliveryBodyBytes {
+		receiver.fail(w, delivery, errorDeliveryBodyTooLarge, http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Ping sent on webhook creation? (unsigned "webhook_id=<id>" form post)
+	if delivery.Topic == "" && isPing(body) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := woocommerce.VerifySignature(r.Header, body, receiver.secret); err != nil {
+		receiver.fail(w, delivery, err, http.StatusUnauthorized)
+		return
+	}
+
+	err = receiver.process(r.Context(), delivery, body)
+	if err != nil {
+		// Failed: let WooCommerce retry the delivery
+		receiver.fail(w, delivery, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// process dispatches a delivery once per logical change when a dedup store is
+// set. Duplicate and stale deliveries are acknowledged without calling handlers.
+func (receiver *Receiver) process(ctx context.Context, delivery *woocommerce.WebhookDelivery, body []byte) error {
+	if receiver.Dedup == nil {
+		return receiver.dispatch(ctx, delivery, body)
+	}
+
+	resourceKey, dedupKey, modified := resourceIdentity(delivery, body)
+
+	if resourceKey != "" {
+		lock := receiver.resourceLock(resourceKey)
+
+		lock.Lock()
+		defer lock.Unlock()
+	}
+
+	if delivery.DeliveryId != "" {
+		seen, err := receiver.Dedup.SeenDelivery(delivery.DeliveryId)
+		if err != nil || seen {
+			return err
+		}
+	}
+
+	// Stale or duplicate change? (WooCommerce fires several updates per change)
+	if dedupKey != "" && !modified.IsZero() {
+		lastModified, ok, err := receiver.Dedup.LastModified(dedupKey)
+		if err != nil {
+			return err
+		}
+
+		if ok && !modified.After(lastModified) {
+			return nil
+		}
+	}
+
+	if err := receiver.dispatch(ctx, delivery, body); err != nil {
+		return err
+	}
+
+	return receiver.Dedup.MarkProcessed(delivery.DeliveryId, dedupKey, modified)
+}
+
+func (receiver *Receiver) resourceLock(resourceKey string) *sync.Mutex {
+	hash := fnv.New32a()
+	hash.Write([]byte(resourceKey))
+
+	return &receiver.resourceLocks[hash.Sum32()%resourceLockStripes]
+}
+
+// dispatch calls the handler registered for the delivery topic. Deliveries
+// without handler are acknowledged, so that WooCommerce does not disable the
+// webhook.
+func (receiver *Receiver) dispatch(ctx context.Context, delivery *woocommerce.WebhookDelivery, body []byte) error {
+	handler := receiver.handler(delivery.Topic)
+	if handler == nil {
+		return nil
+	}
+
+	return handler(ctx, delivery, body)
+}
+
+func (receiver *Receiver) handler(topic woocommerce.WebhookTopic) HandlerFunc {
+	receiver.mu.RLock()
+	defer receiver.mu.RUnlock()
+
+	if handler, ok := receiver.handlers[topic]; ok {
+		return handler
+	}
+
+	if resource := topic.Resource(); resource != "" {
+		if handler, ok := receiver.handlers[woocommerce.WebhookTopic(resource)+".*"]; ok {
+			return handler
+		}
+	}
+
+	return receiver.handlers["*"]
+}
+
+func (receiver *Receiver) fail(w http.ResponseWriter, delivery *woocommerce.WebhookDelivery, err error, status int) {
+	if receiver.OnError != nil {
+		receiver.OnError(delivery, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
+
+func isPing(body []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(body), []byte("webhook_id="))
+}
@@ -0,0 +1,55 @@
+package woocommerce
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// Webhook delivery logs are only exposed by the legacy REST namespaces
+const webhookDeliveriesNamespace = "wc/v2"
+
+// WebhookDeliveryRecord is a logged webhook delivery. WooCommerce 3.3+ logs
+// deliveries with its logger instead, in which case no records are returned.
+type WebhookDeliveryRecord struct {
+	Id              int               `json:"id,omitempty"`
+	Duration        string            `json:"duration,omitempty"`
+	Summary         string            `json:"summary,omitempty"`
+	RequestMethod   string            `json:"request_method,omitempty"`
+	RequestUrl      string            `json:"request_url,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	ResponseCode    string            `json:"response_code,omitempty"`
+	ResponseMessage string            `json:"response_message,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+	DateCreated     *WCTime           `json:"date_created,omitempty"`
+	DateCreatedGmt  *WCTime           `json:"date_created_gmt,omitempty"`
+	Links           *Links            `json:"_links,omitempty"`
+}
+
+// Failed reports whether the delivery did not get a HTTP 2xx response
+func (record *WebhookDeliveryRecord) Failed() bool {
+	code, err := strconv.Atoi(record.ResponseCode)
+
+	return err != nil || code < 200 || code > 299
+}
+
+// List the deliveries of a webhook, most recent first (legacy v2 endpoint)
+func (service *WebhookService) ListDeliveries(webhookId string) ([]WebhookDeliveryRecord, *http.Response, error) {
+	return service.listDeliveries(context.Background(), webhookId)
+}
+
+func (service *WebhookService) listDeliveries(ctx context.Context, webhookId string) ([]WebhookDeliveryRecord, *http.Response, error) {
+	records, response, err := NamespaceCall[[]WebhookDeliveryRecord](ctx, service.client, webhookDeliveriesNamespace, "GET", "/webhooks/"+webhookId+"/deliveries", nil, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return *records, response, nil
+}
+
+// Get a webhook delivery (legacy v2 endpoint)
+func (service *WebhookService) GetDelivery(webhookId string, deliveryId string) (*WebhookDeliveryRecord, *http.Response, error) {
+	return NamespaceCall[WebhookDeliveryRecord](context.Background(), service.client, webhookDeliveriesNamespace, "GET", "/webhooks/"+webhookId+"/deliveries/"+deliveryId, nil, nil)
+}
@@ -0,0 +1,176 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultWebhookMonitorInterval = 5 * time.Minute
+
+// WebhookIssue describes a webhook that does not deliver
+type WebhookIssue struct {
+	Webhook Webhook
+
+	// Status is set when the webhook is paused or disabled (WooCommerce
+	// disables a webhook after consecutive delivery failures)
+	Status WebhookStatus
+
+	// FailedDeliveries holds the most recent consecutive failed deliveries
+	// of an active webhook, when delivery logs are checked
+	FailedDeliveries []WebhookDeliveryRecord
+}
+
+// WebhookMonitor periodically checks the store webhooks and reports the ones
+// paused, disabled or failing. An issue is reported once, and again only after
+// the webhook recovered.
+type WebhookMonitor struct {
+	service *WebhookService
+
+	// Interval between checks (default 5 minutes)
+	Interval time.Duration
+
+	// CheckDeliveries enables checking the delivery logs of active webhooks
+	// (one request per webhook, see WebhookService.ListDeliveries)
+	CheckDeliveries bool
+
+	// FailureThreshold is the number of consecutive failed deliveries for an
+	// active webhook to be failing (default 1)
+	FailureThreshold int
+
+	OnIssue func(issue WebhookIssue)
+	OnError func(err error)
+
+	mu       sync.Mutex
+	reported map[int]bool
+}
+
+// NewWebhookMonitor creates a monitor reporting webhook issues to onIssue
+func NewWebhookMonitor(service *WebhookService, onIssue func(issue WebhookIssue)) *WebhookMonitor {
+	return &WebhookMonitor{
+		service:  service,
+		Interval: defaultWebhookMonitorInterval,
+		OnIssue:  onIssue,
+		reported: map[int]bool{},
+	}
+}
+
+// Check lists the store webhooks and returns the current issues. Issues not
+// reported yet are passed to OnIssue. Check can be called concurrently with Run.
+// Webhooks whose delivery logs cannot be listed are skipped: their errors are
+// returned along with the issues of the other webhooks.
+func (monitor *WebhookMonitor) Check(ctx context.Context) ([]WebhookIssue, error) {
+	webhooks, err := ListAll[Webhook](ctx, monitor.service.client, monitor.service.client.DefaultNamespace(), "/webhooks", &ListWebhooksParams{Status: "all"})
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []WebhookIssue
+	var errs []error
+	failing := map[int]bool{}
+	unchecked := map[int]bool{}
+
+	for _, webhook := range webhooks {
+		issue, ok, err := monitor.checkWebhook(ctx, webhook)
+		if err != nil {
+			// Keep checking the other webhooks
+			errs = append(errs, fmt.Errorf("webhook %d deliveries: %w", webhook.Id, err))
+			unchecked[webhook.Id] = true
+
+			continue
+		}
+
+		if ok {
+			issues = append(issues, issue)
+			failing[webhook.Id] = true
+		}
+	}
+
+	monitor.mu.Lock()
+
+	// Unchecked webhooks keep their reported issue
+	for id := range unchecked {
+		if monitor.reported[id] {
+			failing[id] = true
+		}
+	}
+
+	var newIssues []WebhookIssue
+	for _, issue := range issues {
+		if !monitor.reported[issue.Webhook.Id] {
+			newIssues = append(newIssues, issue)
+		}
+	}
+
+	// Forget recovered (or deleted) webhooks, to report them again on failure
+	monitor.reported = failing
+
+	monitor.mu.Unlock()
+
+	if monitor.OnIssue != nil {
+		for _, issue := range newIssues {
+			monitor.OnIssue(issue)
+		}
+	}
+
+	return issues, errors.Join(errs...)
+}
+
+// Run checks the webhooks every interval until the context is done. Check
+// errors are passed to OnError and do not stop the monitor.
+func (monitor *WebhookMonitor) Run(ctx context.Context) error {
+	interval := monitor.Interval
+	if interval <= 0 {
+		interval = defaultWebhookMonitorInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := monitor.Check(ctx)
+		if err != nil && ctx.Err() == nil && monitor.OnError != nil {
+			monitor.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (monitor *WebhookMonitor) checkWebhook(ctx context.Context, webhook Webhook) (WebhookIssue, bool, error) {
+	if webhook.Status == WebhookStatusPaused || webhook.Status == WebhookStatusDisabled {
+		return WebhookIssue{Webhook: webhook, Status: webhook.Status}, true, nil
+	}
+
+	if !monitor.CheckDeliveries {
+		return WebhookIssue{}, false, nil
+	}
+
+	records, _, err := monitor.service.listDeliveries(ctx, strconv.Itoa(webhook.Id))
+	if err != nil {
+		return WebhookIssue{}, false, err
+	}
+
+	threshold := monitor.FailureThreshold
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	failed := 0
+	for failed < len(records) && records[failed].Failed() {
+		failed++
+	}
+
+	if failed < threshold {
+		return WebhookIssue{}, false, nil
+	}
+
+	return WebhookIssue{Webhook: webhook, FailedDeliveries: records[:failed]}, true, nil
+}
@@ -0,0 +1,286 @@
+package woocommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Maximum objects per batch request accepted by the REST API
+const maxBatchObjects = 100
+
+// WebhookChange describes an existing webhook that differs from the desired
+// one with the same topic and delivery URL
+type WebhookChange struct {
+	Current Webhook
+
+	// Update holds the webhook ID and the fields to change
+	Update Webhook
+
+	// Reenable is set when the webhook is paused or disabled (eg. by
+	// WooCommerce after consecutive delivery failures) and must be activated
+	Reenable bool
+}
+
+// WebhookPlan describes the batch operations needed to reach a desired set
+// of webhooks. Operations may be removed from a plan before it is applied.
+type WebhookPlan struct {
+	Create []Webhook
+	Update []WebhookChange
+	Delete []Webhook
+}
+
+// ReconcileWebhooksParams are the options of Plan and Reconcile
+type ReconcileWebhooksParams struct {
+	// RotateSecrets sets the secret of matched webhooks to the desired one.
+	// The API never returns secrets, so they cannot be compared and are only
+	// sent when rotating.
+	RotateSecrets bool
+
+	// Prune deletes the managed store webhooks not matching a desired one,
+	// including duplicates. Without it, nothing is deleted.
+	Prune bool
+
+	// ManagedPrefix is the name prefix of the webhooks managed by the caller.
+	// When empty, managed webhooks are the ones delivering to a desired
+	// delivery URL, so that webhooks of other plugins are never deleted.
+	ManagedPrefix string
+}
+
+// Empty reports whether the store webhooks already match the desired ones
+func (plan *WebhookPlan) Empty() bool {
+	return len(plan.Create) == 0 && len(plan.Update) == 0 && len(plan.Delete) == 0
+}
+
+// Plan compares desired webhooks with the store webhooks, matching them by
+// topic and delivery URL, without changing anything (a dry run of Reconcile).
+// Desired webhooks without status are expected to be active. With Prune,
+// managed store webhooks not matching a desired one are planned for deletion.
+func (service *WebhookService) Plan(desired []Webhook, params *ReconcileWebhooksParams) (*WebhookPlan, error) {
+	if params == nil {
+		params = &ReconcileWebhooksParams{}
+	}
+
+	// Check topics before comparing, as unknown ones would never match
+	for _, webhook := range desired {
+		if err := webhook.Topic.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := ListAll[Webhook](context.Background(), service.client, service.client.DefaultNamespace(), "/webhooks", &ListWebhooksParams{Status: "all"})
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string][]Webhook{}
+	for _, webhook := range existing {
+		key := webhookKey(webhook)
+		current[key] = append(current[key], webhook)
+	}
+
+	plan := &WebhookPlan{}
+	matched := map[int]bool{}
+	deliveryUrls := map[string]bool{}
+
+	for _, webhook := range desired {
+		deliveryUrls[webhook.DeliveryUrl] = true
+
+		if webhook.Status == "" {
+			webhook.Status = WebhookStatusActive
+		}
+
+		candidates := current[webhookKey(webhook)]
+
+		// Not in store, or all matching webhooks already used by a desired duplicate?
+		if len(candidates) == 0 {
+			plan.Create = append(plan.Create, webhook)
+
+			continue
+		}
+
+		// Prefer a duplicate already in the desired status
+		index := 0
+		for candidate := range candidates {
+			if candidates[candidate].Status == webhook.Status {
+				index = candidate
+				break
+			}
+		}
+
+		currentWebhook := candidates[index]
+		current[webhookKey(webhook)] = append(candidates[:index:index], candidates[index+1:]...)
+		matched[currentWebhook.Id] = true
+
+		if change, ok := diffWebhook(currentWebhook, webhook, params.RotateSecrets); ok {
+			plan.Update = append(plan.Update, change)
+		}
+	}
+
+	if !params.Prune {
+		return plan, nil
+	}
+
+	for _, webhook := range existing {
+		if matched[webhook.Id] {
+			continue
+		}
+
+		managed := deliveryUrls[webhook.DeliveryUrl]
+		if params.ManagedPrefix != "" {
+			managed = strings.HasPrefix(webhook.Name, params.ManagedPrefix)
+		}
+
+		if managed {
+			plan.Delete = append(plan.Delete, webhook)
+		}
+	}
+
+	return plan, nil
+}
+
+// Reconcile makes the store webhooks match the desired ones: missing webhooks
+// are created, changed or paused/disabled ones updated and, with Prune,
+// unmatched managed ones deleted, through batch requests. It returns the
+// applied plan.
+func (service *WebhookService) Reconcile(desired []Webhook, params *ReconcileWebhooksParams) (*WebhookPlan, error) {
+	plan, err := service.Plan(desired, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, service.ApplyPlan(plan)
+}
+
+// ApplyPlan applies a plan returned by Plan through batch requests. Items
+// rejected by WooCommerce are reported together in the returned error, the
+// other ones are applied.
+func (service *WebhookService) ApplyPlan(plan *WebhookPlan) error {
+	var batches []BatchWebhookUpdate
+
+	batch := BatchWebhookUpdate{}
+	size := 0
+
+	next := func() {
+		size++
+
+		if size == maxBatchObjects {
+			batches = append(batches, batch)
+			batch = BatchWebhookUpdate{}
+			size = 0
+		}
+	}
+
+	for _, webhook := range plan.Create {
+		batch.Create = appendWebhook(batch.Create, webhook)
+		next()
+	}
+
+	for _, change := range plan.Update {
+		batch.Update = appendWebhook(batch.Update, change.Update)
+		next()
+	}
+
+	for _, webhook := range plan.Delete {
+		if batch.Delete == nil {
+			batch.Delete = &[]int{}
+		}
+
+		*batch.Delete = append(*batch.Delete, webhook.Id)
+		next()
+	}
+
+	if size > 0 {
+		batches = append(batches, batch)
+	}
+
+	var failed []error
+
+	for index := range batches {
+		result, _, err := service.Batch(&batches[index])
+		if err != nil {
+			return errors.Join(append(failed, err)...)
+		}
+
+		failed = append(failed, batchWebhookErrors(&batches[index], result)...)
+	}
+
+	return errors.Join(failed...)
+}
+
+// batchWebhookErrors returns the errors of the failed items of a batch, whose
+// results are in the order of the request
+func batchWebhookErrors(batch *BatchWebhookUpdate, result *BatchWebhookUpdateResponse) []error {
+	var failed []error
+
+	if batch.Create != nil && result.Create != nil {
+		for index, webhook := range *result.Create {
+			if webhook.Error != nil && index < len(*batch.Create) {
+				failed = append(failed, fmt.Errorf("create webhook %s %s: %w", (*batch.Create)[index].Topic, (*batch.Create)[index].DeliveryUrl, webhook.Error))
+			}
+		}
+	}
+
+	if result.Update != nil {
+		for _, webhook := range *result.Update {
+			if webhook.Error != nil {
+				failed = append(failed, fmt.Errorf("update webhook %d: %w", webhook.Id, webhook.Error))
+			}
+		}
+	}
+
+	if result.Delete != nil {
+		for _, webhook := range *result.Delete {
+			if webhook.Error != nil {
+				failed = append(failed, fmt.Errorf("delete webhook %d: %w", webhook.Id, webhook.Error))
+			}
+		}
+	}
+
+	return failed
+}
+
+// diffWebhook returns the update for a store webhook, containing only the
+// desired fields that differ. The secret is only set when rotating secrets.
+func diffWebhook(current Webhook, desired Webhook, rotateSecret bool) (WebhookChange, bool) {
+	update := Webhook{Id: current.Id}
+	changed := false
+
+	if desired.Name != "" && desired.Name != current.Name {
+		update.Name = desired.Name
+		changed = true
+	}
+
+	if rotateSecret && desired.Secret != "" {
+		update.Secret = desired.Secret
+		changed = true
+	}
+
+	if desired.Status != current.Status {
+		update.Status = desired.Status
+		changed = true
+	}
+
+	change := WebhookChange{
+		Current:  current,
+		Update:   update,
+		Reenable: desired.Status == WebhookStatusActive && current.Status != WebhookStatusActive,
+	}
+
+	return change, changed
+}
+
+func webhookKey(webhook Webhook) string {
+	return string(webhook.Topic) + " " + webhook.DeliveryUrl
+}
+
+func appendWebhook(webhooks *[]Webhook, webhook Webhook) *[]Webhook {
+	if webhooks == nil {
+		webhooks = &[]Webhook{}
+	}
+
+	*webhooks = append(*webhooks, webhook)
+
+	return webhooks
+}
@@ -0,0 +1,78 @@
+package woocommerce
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	WebhookHeaderSource     = "X-WC-Webhook-Source"
+	WebhookHeaderTopic      = "X-WC-Webhook-Topic"
+	WebhookHeaderResource   = "X-WC-Webhook-Resource"
+	WebhookHeaderEvent      = "X-WC-Webhook-Event"
+	WebhookHeaderSignature  = "X-WC-Webhook-Signature"
+	WebhookHeaderID         = "X-WC-Webhook-ID"
+	WebhookHeaderDeliveryID = "X-WC-Webhook-Delivery-ID"
+)
+
+var (
+	ErrWebhookMissingSignature = errors.New("webhook delivery has no signature")
+	ErrWebhookInvalidSignature = errors.New("webhook delivery signature does not match")
+)
+
+// WebhookDelivery holds the X-WC-Webhook-* headers of a webhook delivery
+type WebhookDelivery struct {
+	Source     string
+	Topic      WebhookTopic
+	Resource   WebhookResource
+	Event      WebhookEvent
+	Signature  string
+	WebhookId  int
+	DeliveryId string
+}
+
+// ParseWebhookHeaders reads the X-WC-Webhook-* headers of a webhook delivery
+func ParseWebhookHeaders(header http.Header) *WebhookDelivery {
+	delivery := &WebhookDelivery{
+		Source:     header.Get(WebhookHeaderSource),
+		Topic:      WebhookTopic(header.Get(WebhookHeaderTopic)),
+		Resource:   WebhookResource(header.Get(WebhookHeaderResource)),
+		Event:      WebhookEvent(header.Get(WebhookHeaderEvent)),
+		Signature:  header.Get(WebhookHeaderSignature),
+		DeliveryId: header.Get(WebhookHeaderDeliveryID),
+	}
+
+	delivery.WebhookId, _ = strconv.Atoi(header.Get(WebhookHeaderID))
+
+	return delivery
+}
+
+// ComputeSignature returns the signature of a webhook delivery body: the
+// base64 encoded HMAC-SHA256 of the raw body, keyed with the webhook secret
+func ComputeSignature(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks the X-WC-Webhook-Signature header of a webhook
+// delivery against its raw body, in constant time
+func VerifySignature(header http.Header, body []byte, secret string) error {
+	signature := header.Get(WebhookHeaderSignature)
+	if signature == "" {
+		return ErrWebhookMissingSignature
+	}
+
+	expected := ComputeSignature(body, secret)
+
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrWebhookInvalidSignature
+	}
+
+	return nil
+}
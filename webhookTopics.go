@@ -0,0 +1,139 @@
+package woocommerce
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WebhookTopic is the topic of a webhook (eg. "order.created"), composed of a
+// resource and an event, or an action hook (eg. "action.woocommerce_add_to_cart")
+type WebhookTopic string
+
+// WebhookResource is the resource of a webhook topic (eg. "order")
+type WebhookResource string
+
+// WebhookEvent is the event of a webhook topic (eg. "created")
+type WebhookEvent string
+
+// WebhookStatus is the delivery status of a webhook
+type WebhookStatus string
+
+const (
+	WebhookResourceCoupon   WebhookResource = "coupon"
+	WebhookResourceCustomer WebhookResource = "customer"
+	WebhookResourceOrder    WebhookResource = "order"
+	WebhookResourceProduct  WebhookResource = "product"
+
+	// Custom topics fired on a WordPress action hook
+	WebhookResourceAction WebhookResource = "action"
+)
+
+const (
+	WebhookEventCreated  WebhookEvent = "created"
+	WebhookEventUpdated  WebhookEvent = "updated"
+	WebhookEventDeleted  WebhookEvent = "deleted"
+	WebhookEventRestored WebhookEvent = "restored"
+)
+
+const (
+	WebhookTopicCouponCreated  WebhookTopic = "coupon.created"
+	WebhookTopicCouponUpdated  WebhookTopic = "coupon.updated"
+	WebhookTopicCouponDeleted  WebhookTopic = "coupon.deleted"
+	WebhookTopicCouponRestored WebhookTopic = "coupon.restored"
+
+	WebhookTopicCustomerCreated WebhookTopic = "customer.created"
+	WebhookTopicCustomerUpdated WebhookTopic = "customer.updated"
+	WebhookTopicCustomerDeleted WebhookTopic = "customer.deleted"
+
+	WebhookTopicOrderCreated  WebhookTopic = "order.created"
+	WebhookTopicOrderUpdated  WebhookTopic = "order.updated"
+	WebhookTopicOrderDeleted  WebhookTopic = "order.deleted"
+	WebhookTopicOrderRestored WebhookTopic = "order.restored"
+
+	WebhookTopicProductCreated  WebhookTopic = "product.created"
+	WebhookTopicProductUpdated  WebhookTopic = "product.updated"
+	WebhookTopicProductDeleted  WebhookTopic = "product.deleted"
+	WebhookTopicProductRestored WebhookTopic = "product.restored"
+)
+
+const (
+	WebhookStatusActive   WebhookStatus = "active"
+	WebhookStatusPaused   WebhookStatus = "paused"
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+// Events supported by each core resource
+var webhookResourceEvents = map[WebhookResource][]WebhookEvent{
+	WebhookResourceCoupon:   {WebhookEventCreated, WebhookEventUpdated, WebhookEventDeleted, WebhookEventRestored},
+	WebhookResourceCustomer: {WebhookEventCreated, WebhookEventUpdated, WebhookEventDeleted},
+	WebhookResourceOrder:    {WebhookEventCreated, WebhookEventUpdated, WebhookEventDeleted, WebhookEventRestored},
+	WebhookResourceProduct:  {WebhookEventCreated, WebhookEventUpdated, WebhookEventDeleted, WebhookEventRestored},
+}
+
+// NewWebhookTopic composes a topic from a resource and an event
+func NewWebhookTopic(resource WebhookResource, event WebhookEvent) WebhookTopic {
+	return WebhookTopic(string(resource) + "." + string(event))
+}
+
+// NewActionWebhookTopic composes a custom topic fired on a WordPress action
+// hook (eg. "woocommerce_add_to_cart")
+func NewActionWebhookTopic(hook string) WebhookTopic {
+	return NewWebhookTopic(WebhookResourceAction, WebhookEvent(hook))
+}
+
+// ParseWebhookTopic splits a topic into its resource and event. For action
+// topics the event is the action hook name.
+func ParseWebhookTopic(topic string) (WebhookResource, WebhookEvent, error) {
+	resource, event, found := strings.Cut(topic, ".")
+	if !found || resource == "" || event == "" {
+		return "", "", fmt.Errorf("invalid webhook topic %q: expected <resource>.<event>", topic)
+	}
+
+	return WebhookResource(resource), WebhookEvent(event), nil
+}
+
+// Resource returns the resource of the topic, or an empty string if malformed
+func (topic WebhookTopic) Resource() WebhookResource {
+	resource, _, _ := ParseWebhookTopic(string(topic))
+
+	return resource
+}
+
+// Event returns the event of the topic, or an empty string if malformed
+func (topic WebhookTopic) Event() WebhookEvent {
+	_, event, _ := ParseWebhookTopic(string(topic))
+
+	return event
+}
+
+// IsAction reports whether the topic is fired on a WordPress action hook
+func (topic WebhookTopic) IsAction() bool {
+	return topic.Resource() == WebhookResourceAction
+}
+
+// Validate checks that the topic is well formed, that its resource is a core
+// resource (eg. "odrer.created" is rejected) and that the event exists (eg.
+// "order.update" is rejected). Action topics accept any hook name.
+func (topic WebhookTopic) Validate() error {
+	resource, event, err := ParseWebhookTopic(string(topic))
+	if err != nil {
+		return err
+	}
+
+	if resource == WebhookResourceAction {
+		return nil
+	}
+
+	events, ok := webhookResourceEvents[resource]
+	if !ok {
+		return fmt.Errorf("invalid webhook topic %q: unknown resource %q", topic, resource)
+	}
+
+	for _, supported := range events {
+		if event == supported {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid webhook topic %q: %s has no %q event", topic, resource, event)
+}
@@ -10,18 +10,21 @@ type WebhookService service
 type Webhook struct {
   Id                   int         `json:"id,omitempty"`
   Name                 string      `json:"name,omitempty"`
-  Status               string      `json:"status,omitempty"`
-  Topic                string      `json:"topic,omitempty"`
-  Resource             string      `json:"resource,omitempty"`
-  Event                string      `json:"event,omitempty"`
+  Status               WebhookStatus    `json:"status,omitempty"`
+  Topic                WebhookTopic     `json:"topic,omitempty"`
+  Resource             WebhookResource  `json:"resource,omitempty"`
+  Event                WebhookEvent     `json:"event,omitempty"`
   Hooks                []string    `json:"hooks,omitempty"`
   DeliveryUrl          string      `json:"delivery_url,omitempty"`
   Secret               string      `json:"secret,omitempty"`
-  DateCreated          string      `json:"date_created,omitempty"`
-  DateCreatedGmt       string      `json:"date_created_gmt,omitempty"`
-  DateModified         string      `json:"date_modified,omitempty"`
-  DateModifiedGmt      string      `json:"date_modified_gmt,omitempty"`
+  DateCreated          *WCTime     `json:"date_created,omitempty"`
+  DateCreatedGmt       *WCTime     `json:"date_created_gmt,omitempty"`
+  DateModified         *WCTime     `json:"date_modified,omitempty"`
+  DateModifiedGmt      *WCTime     `json:"date_modified_gmt,omitempty"`
   Links                *Links      `json:"links,omitempty"`
+
+  // Error is set on failed items of batch responses
+  Error                *BatchItemError  `json:"error,omitempty"`
 }
 
 type ListWebhooksParams struct {
@@ -46,16 +49,23 @@ type DeleteWebhookParams struct {
 
 type BatchWebhookUpdate struct {
   Create  *[]Webhook  `json:"create,omitempty"`
+  Update  *[]Webhook  `json:"update,omitempty"`
   Delete  *[]int      `json:"delete,omitempty"`
 }
 
 type BatchWebhookUpdateResponse struct {
   Create  *[]Webhook  `json:"create,omitempty"`
+  Update  *[]Webhook  `json:"update,omitempty"`
   Delete  *[]Webhook  `json:"delete,omitempty"`
 }
 
 // Create a webhook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-webhook
 func (service *WebhookService) Create(webhook *Webhook) (*Webhook, *http.Response, error) {
+  // Check topic (typos are otherwise silently accepted by the API)
+  if err := webhook.Topic.Validate(); err != nil {
+    return nil, nil, err
+  }
+
   _url := "/webhooks" 
   req, _ := service.client.NewRequest("POST", _url, nil, webhook)
 
@@ -101,6 +111,13 @@ func (service *WebhookService) List(opts *ListWebhooksParams) (*[]Webhook,  *htt
 
 // Update a webhook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-webhook
 func (service *WebhookService) Update(webhookID string, webhook *Webhook) (*Webhook, *http.Response, error) {
+  // Check topic, when changed
+  if webhook.Topic != "" {
+    if err := webhook.Topic.Validate(); err != nil {
+      return nil, nil, err
+    }
+  }
+
   _url := "/webhooks/" + webhookID
   req, _ := service.client.NewRequest("PUT", _url, nil, webhook)
 
@@ -131,6 +148,10 @@ func (service *WebhookService) Delete(webhookID string, opts *DeleteWebhookParam
 
 // Batch update webhooks. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-webhooks
 func (service *WebhookService) Batch(opts *BatchWebhookUpdate) (*BatchWebhookUpdateResponse, *http.Response, error) {
+  if err := opts.validate(); err != nil {
+    return nil, nil, err
+  }
+
   _url := "/webhooks/batch"
   req, _ := service.client.NewRequest("POST", _url, nil, opts)
 
@@ -142,4 +163,34 @@ func (service *WebhookService) Batch(opts *BatchWebhookUpdate) (*BatchWebhookUpd
   }
 
   return webhooks, response, nil
-}
\ No newline at end of file
+}
+
+// validate checks the topics of the created webhooks, and of the updated ones
+// changing their topic
+func (batch *BatchWebhookUpdate) validate() error {
+  if batch == nil {
+    return nil
+  }
+
+  if batch.Create != nil {
+    for _, webhook := range *batch.Create {
+      if err := webhook.Topic.Validate(); err != nil {
+        return err
+      }
+    }
+  }
+
+  if batch.Update != nil {
+    for _, webhook := range *batch.Update {
+      if webhook.Topic == "" {
+        continue
+      }
+
+      if err := webhook.Topic.Validate(); err != nil {
+        return err
+      }
+    }
+  }
+
+  return nil
+}
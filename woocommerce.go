@@ -44,14 +44,23 @@ type Client struct {
 	auth    *auth
 	baseURL *url.URL
 
+	Analytics         *AnalyticsService
 	Coupons           *CouponsService
 	Customers         *CustomersService
+	Data              *DataService
 	Orders            *OrdersService
 	OrderNotes        *OrderNotesService
+	PaymentGateways   *PaymentGatewaysService
 	Refunds           *RefundsService
+	Reports           *ReportsService
 	Products          *ProductsService
 	ProductTags       *ProductTagService
 	ProductVariations *ProductVariationService
+	Settings          *SettingsService
+	ShippingZones     *ShippingZonesService
+	ShippingMethods   *ShippingMethodsService
+	SystemStatus      *SystemStatusService
+	SystemStatusTools *SystemStatusToolsService
 	Webhooks          *WebhookService
 }
 
@@ -67,10 +76,26 @@ type errorResponse struct {
 	Data    ErrorData `json:"data"`
 }
 
+// ErrorResponse is the error returned for API error responses (eg. HTTP 4xx),
+// it can be inspected with errors.As
+type ErrorResponse = errorResponse
+
 type ErrorData struct {
 	Status int `json:"status"`
 }
 
+// BatchItemError is the error of a batch request item. Batch requests succeed
+// even when some items fail, the failed items hold their error.
+type BatchItemError struct {
+	Code    string    `json:"code"`
+	Message string    `json:"message"`
+	Data    ErrorData `json:"data"`
+}
+
+func (err *BatchItemError) Error() string {
+	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
+}
+
 func (response *errorResponse) Error() string {
 	return fmt.Sprintf("%v %v: %d %v",
 		response.Response.Request.Method, response.Response.Request.URL,
@@ -91,7 +116,7 @@ func New(shopURL string) (*Client, error) {
 	config.RestEndpointVersion = defaultRestEndpointVersion
 
 	// Create client
-	baseURL, err := url.Parse(config.RestEndpointURL + "/wp-json/wc/" + defaultRestEndpointVersion)
+	baseURL, err := url.Parse(config.RestEndpointURL + "/wp-json/")
 
 	if err != nil {
 		return nil, err
@@ -100,14 +125,23 @@ func New(shopURL string) (*Client, error) {
 	client := &Client{config: &config, client: config.HttpClient, auth: &auth{}, baseURL: baseURL}
 
 	// Map services
+	client.Analytics = &AnalyticsService{client: client}
 	client.Coupons = &CouponsService{client: client}
 	client.Customers = &CustomersService{client: client}
+	client.Data = &DataService{client: client}
 	client.Orders = &OrdersService{client: client}
 	client.OrderNotes = &OrderNotesService{client: client}
+	client.PaymentGateways = &PaymentGatewaysService{client: client}
 	client.Refunds = &RefundsService{client: client}
+	client.Reports = &ReportsService{client: client}
 	client.Products = &ProductsService{client: client}
 	client.ProductTags = &ProductTagService{client: client}
 	client.ProductVariations = &ProductVariationService{client: client}
+	client.Settings = &SettingsService{client: client}
+	client.ShippingZones = &ShippingZonesService{client: client}
+	client.ShippingMethods = &ShippingMethodsService{client: client}
+	client.SystemStatus = &SystemStatusService{client: client}
+	client.SystemStatusTools = &SystemStatusToolsService{client: client}
 	client.Webhooks = &WebhookService{client: client}
 
 	return client, nil
@@ -120,8 +154,19 @@ func (client *Client) Authenticate(consumer_key string, consumer_secret string)
 	client.auth.ApiKey = "Basic " + base64.StdEncoding.EncodeToString([]byte(strings.Join([]string{consumer_key, consumer_secret}, ":")))
 }
 
+// DefaultNamespace returns the namespace of the client WooCommerce REST API (eg. "wc/v3")
+func (client *Client) DefaultNamespace() string {
+	return "wc/" + client.config.RestEndpointVersion
+}
+
 // NewRequest creates an API request
 func (client *Client) NewRequest(method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
+	return client.NewNamespaceRequest(method, client.DefaultNamespace(), urlStr, opts, body)
+}
+
+// NewNamespaceRequest creates an API request for a REST namespace other than
+// the default WooCommerce one (eg. "wc-analytics" or "wc/v1")
+func (client *Client) NewNamespaceRequest(method, namespace, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
 	// Append Query Params to URL
 	if opts != nil {
 		queryParams, err := query.Values(opts)
@@ -137,7 +182,7 @@ func (client *Client) NewRequest(method, urlStr string, opts interface{}, body i
 		}
 	}
 
-	rel, err := url.Parse(client.config.RestEndpointVersion + urlStr)
+	rel, err := url.Parse(namespace + urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +204,11 @@ func (client *Client) NewRequest(method, urlStr string, opts interface{}, body i
 		return nil, err
 	}
 
-	req.Header.Add(client.auth.HeaderName, client.auth.ApiKey)
+	// Add authentication? (not set for public APIs, eg. the Store API)
+	if client.auth.HeaderName != "" {
+		req.Header.Add(client.auth.HeaderName, client.auth.ApiKey)
+	}
+
 	req.Header.Add("Accept", acceptedContentType)
 	req.Header.Add("Content-type", acceptedContentType)
 	req.Header.Add("User-Agent", userAgent)